@@ -20,14 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Cluster_GetStatus_FullMethodName    = "/ceph.Cluster/GetStatus"
-	Cluster_UpdateStatus_FullMethodName = "/ceph.Cluster/UpdateStatus"
-	Cluster_GetUsers_FullMethodName     = "/ceph.Cluster/GetUsers"
-	Cluster_UpdateUser_FullMethodName   = "/ceph.Cluster/UpdateUser"
-	Cluster_CreateUser_FullMethodName   = "/ceph.Cluster/CreateUser"
-	Cluster_ExportUser_FullMethodName   = "/ceph.Cluster/ExportUser"
-	Cluster_DeleteUser_FullMethodName   = "/ceph.Cluster/DeleteUser"
-	Cluster_SearchConfig_FullMethodName = "/ceph.Cluster/SearchConfig"
+	Cluster_GetStatus_FullMethodName        = "/ceph.Cluster/GetStatus"
+	Cluster_UpdateStatus_FullMethodName     = "/ceph.Cluster/UpdateStatus"
+	Cluster_GetUsers_FullMethodName         = "/ceph.Cluster/GetUsers"
+	Cluster_UpdateUser_FullMethodName       = "/ceph.Cluster/UpdateUser"
+	Cluster_CreateUser_FullMethodName       = "/ceph.Cluster/CreateUser"
+	Cluster_ExportUser_FullMethodName       = "/ceph.Cluster/ExportUser"
+	Cluster_DeleteUser_FullMethodName       = "/ceph.Cluster/DeleteUser"
+	Cluster_SearchConfig_FullMethodName     = "/ceph.Cluster/SearchConfig"
+	Cluster_GetConfig_FullMethodName        = "/ceph.Cluster/GetConfig"
+	Cluster_SetConfig_FullMethodName        = "/ceph.Cluster/SetConfig"
+	Cluster_RemoveConfig_FullMethodName     = "/ceph.Cluster/RemoveConfig"
+	Cluster_DumpConfig_FullMethodName       = "/ceph.Cluster/DumpConfig"
+	Cluster_GetConfigHistory_FullMethodName = "/ceph.Cluster/GetConfigHistory"
+	Cluster_RollbackConfig_FullMethodName   = "/ceph.Cluster/RollbackConfig"
 )
 
 // ClusterClient is the client API for Cluster service.
@@ -44,6 +50,18 @@ type ClusterClient interface {
 	ExportUser(ctx context.Context, in *ExportClusterUserReq, opts ...grpc.CallOption) (*ExportClusterUserResp, error)
 	DeleteUser(ctx context.Context, in *DeleteClusterUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SearchConfig(ctx context.Context, in *SearchConfigRequest, opts ...grpc.CallOption) (*SearchConfigResponse, error)
+	// command: ceph config get
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	// command: ceph config set
+	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*SetConfigResponse, error)
+	// command: ceph config rm
+	RemoveConfig(ctx context.Context, in *RemoveConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph config dump
+	DumpConfig(ctx context.Context, in *DumpConfigRequest, opts ...grpc.CallOption) (*DumpConfigResponse, error)
+	// command: ceph config log
+	GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error)
+	// command: ceph config reset
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type clusterClient struct {
@@ -134,6 +152,66 @@ func (c *clusterClient) SearchConfig(ctx context.Context, in *SearchConfigReques
 	return out, nil
 }
 
+func (c *clusterClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, Cluster_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*SetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConfigResponse)
+	err := c.cc.Invoke(ctx, Cluster_SetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) RemoveConfig(ctx context.Context, in *RemoveConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cluster_RemoveConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) DumpConfig(ctx context.Context, in *DumpConfigRequest, opts ...grpc.CallOption) (*DumpConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DumpConfigResponse)
+	err := c.cc.Invoke(ctx, Cluster_DumpConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, Cluster_GetConfigHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cluster_RollbackConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 // All implementations should embed UnimplementedClusterServer
 // for forward compatibility.
@@ -148,6 +226,18 @@ type ClusterServer interface {
 	ExportUser(context.Context, *ExportClusterUserReq) (*ExportClusterUserResp, error)
 	DeleteUser(context.Context, *DeleteClusterUserReq) (*emptypb.Empty, error)
 	SearchConfig(context.Context, *SearchConfigRequest) (*SearchConfigResponse, error)
+	// command: ceph config get
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	// command: ceph config set
+	SetConfig(context.Context, *SetConfigRequest) (*SetConfigResponse, error)
+	// command: ceph config rm
+	RemoveConfig(context.Context, *RemoveConfigRequest) (*emptypb.Empty, error)
+	// command: ceph config dump
+	DumpConfig(context.Context, *DumpConfigRequest) (*DumpConfigResponse, error)
+	// command: ceph config log
+	GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error)
+	// command: ceph config reset
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedClusterServer should be embedded to have
@@ -181,6 +271,24 @@ func (UnimplementedClusterServer) DeleteUser(context.Context, *DeleteClusterUser
 func (UnimplementedClusterServer) SearchConfig(context.Context, *SearchConfigRequest) (*SearchConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchConfig not implemented")
 }
+func (UnimplementedClusterServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedClusterServer) SetConfig(context.Context, *SetConfigRequest) (*SetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
+}
+func (UnimplementedClusterServer) RemoveConfig(context.Context, *RemoveConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveConfig not implemented")
+}
+func (UnimplementedClusterServer) DumpConfig(context.Context, *DumpConfigRequest) (*DumpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpConfig not implemented")
+}
+func (UnimplementedClusterServer) GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigHistory not implemented")
+}
+func (UnimplementedClusterServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
 func (UnimplementedClusterServer) testEmbeddedByValue() {}
 
 // UnsafeClusterServer may be embedded to opt out of forward compatibility for this service.
@@ -345,6 +453,114 @@ func _Cluster_SearchConfig_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).SetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_SetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SetConfig(ctx, req.(*SetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_RemoveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).RemoveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_RemoveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).RemoveConfig(ctx, req.(*RemoveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_DumpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).DumpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_DumpConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).DumpConfig(ctx, req.(*DumpConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_GetConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetConfigHistory(ctx, req.(*GetConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -384,6 +600,30 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchConfig",
 			Handler:    _Cluster_SearchConfig_Handler,
 		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _Cluster_GetConfig_Handler,
+		},
+		{
+			MethodName: "SetConfig",
+			Handler:    _Cluster_SetConfig_Handler,
+		},
+		{
+			MethodName: "RemoveConfig",
+			Handler:    _Cluster_RemoveConfig_Handler,
+		},
+		{
+			MethodName: "DumpConfig",
+			Handler:    _Cluster_DumpConfig_Handler,
+		},
+		{
+			MethodName: "GetConfigHistory",
+			Handler:    _Cluster_GetConfigHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _Cluster_RollbackConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cluster.proto",
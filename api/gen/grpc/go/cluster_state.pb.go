@@ -0,0 +1,861 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: cluster_state.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlanItem_Kind int32
+
+const (
+	PlanItem_crush_rule PlanItem_Kind = 0
+	PlanItem_pool       PlanItem_Kind = 1
+	PlanItem_config     PlanItem_Kind = 2
+	PlanItem_user       PlanItem_Kind = 3
+)
+
+// Enum value maps for PlanItem_Kind.
+var (
+	PlanItem_Kind_name = map[int32]string{
+		0: "crush_rule",
+		1: "pool",
+		2: "config",
+		3: "user",
+	}
+	PlanItem_Kind_value = map[string]int32{
+		"crush_rule": 0,
+		"pool":       1,
+		"config":     2,
+		"user":       3,
+	}
+)
+
+func (x PlanItem_Kind) Enum() *PlanItem_Kind {
+	p := new(PlanItem_Kind)
+	*p = x
+	return p
+}
+
+func (x PlanItem_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanItem_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_state_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanItem_Kind) Type() protoreflect.EnumType {
+	return &file_cluster_state_proto_enumTypes[0]
+}
+
+func (x PlanItem_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanItem_Kind.Descriptor instead.
+func (PlanItem_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type PlanItem_Action int32
+
+const (
+	PlanItem_no_change PlanItem_Action = 0
+	PlanItem_create    PlanItem_Action = 1
+	PlanItem_update    PlanItem_Action = 2
+)
+
+// Enum value maps for PlanItem_Action.
+var (
+	PlanItem_Action_name = map[int32]string{
+		0: "no_change",
+		1: "create",
+		2: "update",
+	}
+	PlanItem_Action_value = map[string]int32{
+		"no_change": 0,
+		"create":    1,
+		"update":    2,
+	}
+)
+
+func (x PlanItem_Action) Enum() *PlanItem_Action {
+	p := new(PlanItem_Action)
+	*p = x
+	return p
+}
+
+func (x PlanItem_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanItem_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_state_proto_enumTypes[1].Descriptor()
+}
+
+func (PlanItem_Action) Type() protoreflect.EnumType {
+	return &file_cluster_state_proto_enumTypes[1]
+}
+
+func (x PlanItem_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanItem_Action.Descriptor instead.
+func (PlanItem_Action) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type ApplyItemResult_Status int32
+
+const (
+	ApplyItemResult_unchanged ApplyItemResult_Status = 0
+	ApplyItemResult_applied   ApplyItemResult_Status = 1
+	ApplyItemResult_failed    ApplyItemResult_Status = 2
+	// not applied because of dry run or previous failure
+	ApplyItemResult_skipped ApplyItemResult_Status = 3
+)
+
+// Enum value maps for ApplyItemResult_Status.
+var (
+	ApplyItemResult_Status_name = map[int32]string{
+		0: "unchanged",
+		1: "applied",
+		2: "failed",
+		3: "skipped",
+	}
+	ApplyItemResult_Status_value = map[string]int32{
+		"unchanged": 0,
+		"applied":   1,
+		"failed":    2,
+		"skipped":   3,
+	}
+)
+
+func (x ApplyItemResult_Status) Enum() *ApplyItemResult_Status {
+	p := new(ApplyItemResult_Status)
+	*p = x
+	return p
+}
+
+func (x ApplyItemResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyItemResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_state_proto_enumTypes[2].Descriptor()
+}
+
+func (ApplyItemResult_Status) Type() protoreflect.EnumType {
+	return &file_cluster_state_proto_enumTypes[2]
+}
+
+func (x ApplyItemResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyItemResult_Status.Descriptor instead.
+func (ApplyItemResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type DesiredState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config     []*SetConfigRequest     `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Pools      []*CreatePoolRequest    `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
+	CrushRules []*CreateRuleRequest    `protobuf:"bytes,3,rep,name=crush_rules,proto3" json:"crush_rules,omitempty"`
+	Users      []*UpdateClusterUserReq `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *DesiredState) Reset() {
+	*x = DesiredState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DesiredState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesiredState) ProtoMessage() {}
+
+func (x *DesiredState) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesiredState.ProtoReflect.Descriptor instead.
+func (*DesiredState) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DesiredState) GetConfig() []*SetConfigRequest {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DesiredState) GetPools() []*CreatePoolRequest {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *DesiredState) GetCrushRules() []*CreateRuleRequest {
+	if x != nil {
+		return x.CrushRules
+	}
+	return nil
+}
+
+func (x *DesiredState) GetUsers() []*UpdateClusterUserReq {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type PlanItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind PlanItem_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=ceph.PlanItem_Kind" json:"kind,omitempty"`
+	// resource id: crush rule name, pool name, "<who>/<config param name>" or user entity
+	Name   string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Action PlanItem_Action `protobuf:"varint,3,opt,name=action,proto3,enum=ceph.PlanItem_Action" json:"action,omitempty"`
+	Diff   []*FieldDiff    `protobuf:"bytes,4,rep,name=diff,proto3" json:"diff,omitempty"`
+	// set if change cannot be applied, e.g: pool type cannot be changed.
+	Error *string `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *PlanItem) Reset() {
+	*x = PlanItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanItem) ProtoMessage() {}
+
+func (x *PlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanItem.ProtoReflect.Descriptor instead.
+func (*PlanItem) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanItem) GetKind() PlanItem_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return PlanItem_crush_rule
+}
+
+func (x *PlanItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanItem) GetAction() PlanItem_Action {
+	if x != nil {
+		return x.Action
+	}
+	return PlanItem_no_change
+}
+
+func (x *PlanItem) GetDiff() []*FieldDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PlanItem) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// not set if resource does not exist
+	Current *string `protobuf:"bytes,2,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Desired string  `protobuf:"bytes,3,opt,name=desired,proto3" json:"desired,omitempty"`
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetCurrent() string {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetDesired() string {
+	if x != nil {
+		return x.Desired
+	}
+	return ""
+}
+
+type PlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*PlanItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *PlanResponse) Reset() {
+	*x = PlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResponse) ProtoMessage() {}
+
+func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanResponse) GetItems() []*PlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *DesiredState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// return plan without applying changes
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ApplyRequest) Reset() {
+	*x = ApplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRequest) ProtoMessage() {}
+
+func (x *ApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApplyRequest) GetState() *DesiredState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ApplyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ApplyItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item   *PlanItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Status ApplyItemResult_Status `protobuf:"varint,2,opt,name=status,proto3,enum=ceph.ApplyItemResult_Status" json:"status,omitempty"`
+	Error  *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *ApplyItemResult) Reset() {
+	*x = ApplyItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyItemResult) ProtoMessage() {}
+
+func (x *ApplyItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyItemResult.ProtoReflect.Descriptor instead.
+func (*ApplyItemResult) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplyItemResult) GetItem() *PlanItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ApplyItemResult) GetStatus() ApplyItemResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ApplyItemResult_unchanged
+}
+
+func (x *ApplyItemResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ApplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ApplyItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ApplyResponse) Reset() {
+	*x = ApplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cluster_state_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResponse) ProtoMessage() {}
+
+func (x *ApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_state_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplyResponse) GetResults() []*ApplyItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_cluster_state_proto protoreflect.FileDescriptor
+
+var file_cluster_state_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x65, 0x70, 0x68, 0x1a, 0x0d, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x63, 0x72, 0x75, 0x73,
+	0x68, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x73,
+	0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x65, 0x70, 0x68,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x75, 0x73,
+	0x68, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x65, 0x70, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x27, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x65, 0x70, 0x68, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x22, 0x36,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x63, 0x72, 0x75, 0x73, 0x68, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x10, 0x03, 0x22, 0x2f, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0d, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x66, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x0c, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x52, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x22, 0xcf, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x65,
+	0x70, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x22, 0x3d, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x03, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0x70, 0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x12, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x44, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x12, 0x12, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x79, 0x73, 0x6f, 0x2f, 0x63, 0x65,
+	0x70, 0x68, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x70, 0x68, 0x3b,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cluster_state_proto_rawDescOnce sync.Once
+	file_cluster_state_proto_rawDescData = file_cluster_state_proto_rawDesc
+)
+
+func file_cluster_state_proto_rawDescGZIP() []byte {
+	file_cluster_state_proto_rawDescOnce.Do(func() {
+		file_cluster_state_proto_rawDescData = protoimpl.X.CompressGZIP(file_cluster_state_proto_rawDescData)
+	})
+	return file_cluster_state_proto_rawDescData
+}
+
+var file_cluster_state_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cluster_state_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cluster_state_proto_goTypes = []interface{}{
+	(PlanItem_Kind)(0),           // 0: ceph.PlanItem.Kind
+	(PlanItem_Action)(0),         // 1: ceph.PlanItem.Action
+	(ApplyItemResult_Status)(0),  // 2: ceph.ApplyItemResult.Status
+	(*DesiredState)(nil),         // 3: ceph.DesiredState
+	(*PlanItem)(nil),             // 4: ceph.PlanItem
+	(*FieldDiff)(nil),            // 5: ceph.FieldDiff
+	(*PlanResponse)(nil),         // 6: ceph.PlanResponse
+	(*ApplyRequest)(nil),         // 7: ceph.ApplyRequest
+	(*ApplyItemResult)(nil),      // 8: ceph.ApplyItemResult
+	(*ApplyResponse)(nil),        // 9: ceph.ApplyResponse
+	(*SetConfigRequest)(nil),     // 10: ceph.SetConfigRequest
+	(*CreatePoolRequest)(nil),    // 11: ceph.CreatePoolRequest
+	(*CreateRuleRequest)(nil),    // 12: ceph.CreateRuleRequest
+	(*UpdateClusterUserReq)(nil), // 13: ceph.UpdateClusterUserReq
+}
+var file_cluster_state_proto_depIdxs = []int32{
+	10, // 0: ceph.DesiredState.config:type_name -> ceph.SetConfigRequest
+	11, // 1: ceph.DesiredState.pools:type_name -> ceph.CreatePoolRequest
+	12, // 2: ceph.DesiredState.crush_rules:type_name -> ceph.CreateRuleRequest
+	13, // 3: ceph.DesiredState.users:type_name -> ceph.UpdateClusterUserReq
+	0,  // 4: ceph.PlanItem.kind:type_name -> ceph.PlanItem.Kind
+	1,  // 5: ceph.PlanItem.action:type_name -> ceph.PlanItem.Action
+	5,  // 6: ceph.PlanItem.diff:type_name -> ceph.FieldDiff
+	4,  // 7: ceph.PlanResponse.items:type_name -> ceph.PlanItem
+	3,  // 8: ceph.ApplyRequest.state:type_name -> ceph.DesiredState
+	4,  // 9: ceph.ApplyItemResult.item:type_name -> ceph.PlanItem
+	2,  // 10: ceph.ApplyItemResult.status:type_name -> ceph.ApplyItemResult.Status
+	8,  // 11: ceph.ApplyResponse.results:type_name -> ceph.ApplyItemResult
+	3,  // 12: ceph.ClusterState.Plan:input_type -> ceph.DesiredState
+	7,  // 13: ceph.ClusterState.Apply:input_type -> ceph.ApplyRequest
+	6,  // 14: ceph.ClusterState.Plan:output_type -> ceph.PlanResponse
+	9,  // 15: ceph.ClusterState.Apply:output_type -> ceph.ApplyResponse
+	14, // [14:16] is the sub-list for method output_type
+	12, // [12:14] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_cluster_state_proto_init() }
+func file_cluster_state_proto_init() {
+	if File_cluster_state_proto != nil {
+		return
+	}
+	file_cluster_proto_init()
+	file_crush_rule_proto_init()
+	file_pool_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cluster_state_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DesiredState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cluster_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cluster_state_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_cluster_state_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_cluster_state_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cluster_state_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_state_proto_goTypes,
+		DependencyIndexes: file_cluster_state_proto_depIdxs,
+		EnumInfos:         file_cluster_state_proto_enumTypes,
+		MessageInfos:      file_cluster_state_proto_msgTypes,
+	}.Build()
+	File_cluster_state_proto = out.File
+	file_cluster_state_proto_rawDesc = nil
+	file_cluster_state_proto_goTypes = nil
+	file_cluster_state_proto_depIdxs = nil
+}
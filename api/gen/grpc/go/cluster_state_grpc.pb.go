@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster_state.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClusterState_Plan_FullMethodName  = "/ceph.ClusterState/Plan"
+	ClusterState_Apply_FullMethodName = "/ceph.ClusterState/Apply"
+)
+
+// ClusterStateClient is the client API for ClusterState service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ClusterState applies desired cluster state in terraform-like plan/apply manner.
+// Resources existing in cluster but absent in desired state are not removed.
+type ClusterStateClient interface {
+	// Returns changes required to bring cluster to desired state without applying them.
+	Plan(ctx context.Context, in *DesiredState, opts ...grpc.CallOption) (*PlanResponse, error)
+	// Applies planned changes in order: crush rules, pools, config, cluster users.
+	// Stops on the first failed change.
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+}
+
+type clusterStateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterStateClient(cc grpc.ClientConnInterface) ClusterStateClient {
+	return &clusterStateClient{cc}
+}
+
+func (c *clusterStateClient) Plan(ctx context.Context, in *DesiredState, opts ...grpc.CallOption) (*PlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanResponse)
+	err := c.cc.Invoke(ctx, ClusterState_Plan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterStateClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyResponse)
+	err := c.cc.Invoke(ctx, ClusterState_Apply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterStateServer is the server API for ClusterState service.
+// All implementations should embed UnimplementedClusterStateServer
+// for forward compatibility.
+//
+// ClusterState applies desired cluster state in terraform-like plan/apply manner.
+// Resources existing in cluster but absent in desired state are not removed.
+type ClusterStateServer interface {
+	// Returns changes required to bring cluster to desired state without applying them.
+	Plan(context.Context, *DesiredState) (*PlanResponse, error)
+	// Applies planned changes in order: crush rules, pools, config, cluster users.
+	// Stops on the first failed change.
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+}
+
+// UnimplementedClusterStateServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClusterStateServer struct{}
+
+func (UnimplementedClusterStateServer) Plan(context.Context, *DesiredState) (*PlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (UnimplementedClusterStateServer) Apply(context.Context, *ApplyRequest) (*ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (UnimplementedClusterStateServer) testEmbeddedByValue() {}
+
+// UnsafeClusterStateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterStateServer will
+// result in compilation errors.
+type UnsafeClusterStateServer interface {
+	mustEmbedUnimplementedClusterStateServer()
+}
+
+func RegisterClusterStateServer(s grpc.ServiceRegistrar, srv ClusterStateServer) {
+	// If the following call pancis, it indicates UnimplementedClusterStateServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClusterState_ServiceDesc, srv)
+}
+
+func _ClusterState_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterStateServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterState_Plan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterStateServer).Plan(ctx, req.(*DesiredState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterState_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterStateServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterState_Apply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterStateServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterState_ServiceDesc is the grpc.ServiceDesc for ClusterState service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterState_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ceph.ClusterState",
+	HandlerType: (*ClusterStateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Plan",
+			Handler:    _ClusterState_Plan_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _ClusterState_Apply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster_state.proto",
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: command.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Command_ExecCommand_FullMethodName  = "/ceph.Command/ExecCommand"
+	Command_ListCommands_FullMethodName = "/ceph.Command/ListCommands"
+)
+
+// CommandClient is the client API for Command service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Command executes arbitrary ceph commands validated against command descriptions of the cluster.
+type CommandClient interface {
+	// Validates command arguments against command signature and sends it to mon or mgr.
+	// Required RBAC permissions are derived from command prefix and ceph capability ("perm") of the command.
+	ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error)
+	// Lists available commands and their signatures.
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error)
+}
+
+type commandClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommandClient(cc grpc.ClientConnInterface) CommandClient {
+	return &commandClient{cc}
+}
+
+func (c *commandClient) ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecCommandResponse)
+	err := c.cc.Invoke(ctx, Command_ExecCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandClient) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommandsResponse)
+	err := c.cc.Invoke(ctx, Command_ListCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommandServer is the server API for Command service.
+// All implementations should embed UnimplementedCommandServer
+// for forward compatibility.
+//
+// Command executes arbitrary ceph commands validated against command descriptions of the cluster.
+type CommandServer interface {
+	// Validates command arguments against command signature and sends it to mon or mgr.
+	// Required RBAC permissions are derived from command prefix and ceph capability ("perm") of the command.
+	ExecCommand(context.Context, *ExecCommandRequest) (*ExecCommandResponse, error)
+	// Lists available commands and their signatures.
+	ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error)
+}
+
+// UnimplementedCommandServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommandServer struct{}
+
+func (UnimplementedCommandServer) ExecCommand(context.Context, *ExecCommandRequest) (*ExecCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
+}
+func (UnimplementedCommandServer) ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedCommandServer) testEmbeddedByValue() {}
+
+// UnsafeCommandServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommandServer will
+// result in compilation errors.
+type UnsafeCommandServer interface {
+	mustEmbedUnimplementedCommandServer()
+}
+
+func RegisterCommandServer(s grpc.ServiceRegistrar, srv CommandServer) {
+	// If the following call pancis, it indicates UnimplementedCommandServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Command_ServiceDesc, srv)
+}
+
+func _Command_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServer).ExecCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Command_ExecCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServer).ExecCommand(ctx, req.(*ExecCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Command_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandServer).ListCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Command_ListCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandServer).ListCommands(ctx, req.(*ListCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Command_ServiceDesc is the grpc.ServiceDesc for Command service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Command_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ceph.Command",
+	HandlerType: (*CommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecCommand",
+			Handler:    _Command_ExecCommand_Handler,
+		},
+		{
+			MethodName: "ListCommands",
+			Handler:    _Command_ListCommands_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "command.proto",
+}
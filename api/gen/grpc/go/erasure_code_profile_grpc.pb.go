@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: erasure_code_profile.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ErasureCodeProfile_ListProfiles_FullMethodName  = "/ceph.ErasureCodeProfile/ListProfiles"
+	ErasureCodeProfile_GetProfile_FullMethodName    = "/ceph.ErasureCodeProfile/GetProfile"
+	ErasureCodeProfile_CreateProfile_FullMethodName = "/ceph.ErasureCodeProfile/CreateProfile"
+	ErasureCodeProfile_DeleteProfile_FullMethodName = "/ceph.ErasureCodeProfile/DeleteProfile"
+)
+
+// ErasureCodeProfileClient is the client API for ErasureCodeProfile service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ErasureCodeProfileClient interface {
+	// command: ceph osd erasure-code-profile ls
+	ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	// command: ceph osd erasure-code-profile get
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ErasureProfile, error)
+	// command: ceph osd erasure-code-profile set
+	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd erasure-code-profile rm
+	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type erasureCodeProfileClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewErasureCodeProfileClient(cc grpc.ClientConnInterface) ErasureCodeProfileClient {
+	return &erasureCodeProfileClient{cc}
+}
+
+func (c *erasureCodeProfileClient) ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfilesResponse)
+	err := c.cc.Invoke(ctx, ErasureCodeProfile_ListProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *erasureCodeProfileClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ErasureProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureProfile)
+	err := c.cc.Invoke(ctx, ErasureCodeProfile_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *erasureCodeProfileClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ErasureCodeProfile_CreateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *erasureCodeProfileClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ErasureCodeProfile_DeleteProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ErasureCodeProfileServer is the server API for ErasureCodeProfile service.
+// All implementations should embed UnimplementedErasureCodeProfileServer
+// for forward compatibility.
+type ErasureCodeProfileServer interface {
+	// command: ceph osd erasure-code-profile ls
+	ListProfiles(context.Context, *emptypb.Empty) (*ListProfilesResponse, error)
+	// command: ceph osd erasure-code-profile get
+	GetProfile(context.Context, *GetProfileRequest) (*ErasureProfile, error)
+	// command: ceph osd erasure-code-profile set
+	CreateProfile(context.Context, *CreateProfileRequest) (*emptypb.Empty, error)
+	// command: ceph osd erasure-code-profile rm
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedErasureCodeProfileServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedErasureCodeProfileServer struct{}
+
+func (UnimplementedErasureCodeProfileServer) ListProfiles(context.Context, *emptypb.Empty) (*ListProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedErasureCodeProfileServer) GetProfile(context.Context, *GetProfileRequest) (*ErasureProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedErasureCodeProfileServer) CreateProfile(context.Context, *CreateProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (UnimplementedErasureCodeProfileServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedErasureCodeProfileServer) testEmbeddedByValue() {}
+
+// UnsafeErasureCodeProfileServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ErasureCodeProfileServer will
+// result in compilation errors.
+type UnsafeErasureCodeProfileServer interface {
+	mustEmbedUnimplementedErasureCodeProfileServer()
+}
+
+func RegisterErasureCodeProfileServer(s grpc.ServiceRegistrar, srv ErasureCodeProfileServer) {
+	// If the following call pancis, it indicates UnimplementedErasureCodeProfileServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ErasureCodeProfile_ServiceDesc, srv)
+}
+
+func _ErasureCodeProfile_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureCodeProfileServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureCodeProfile_ListProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureCodeProfileServer).ListProfiles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErasureCodeProfile_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureCodeProfileServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureCodeProfile_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureCodeProfileServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErasureCodeProfile_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureCodeProfileServer).CreateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureCodeProfile_CreateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureCodeProfileServer).CreateProfile(ctx, req.(*CreateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ErasureCodeProfile_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErasureCodeProfileServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErasureCodeProfile_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErasureCodeProfileServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ErasureCodeProfile_ServiceDesc is the grpc.ServiceDesc for ErasureCodeProfile service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ErasureCodeProfile_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ceph.ErasureCodeProfile",
+	HandlerType: (*ErasureCodeProfileServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProfiles",
+			Handler:    _ErasureCodeProfile_ListProfiles_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _ErasureCodeProfile_GetProfile_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _ErasureCodeProfile_CreateProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _ErasureCodeProfile_DeleteProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "erasure_code_profile.proto",
+}
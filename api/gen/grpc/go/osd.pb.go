@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: osd.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OsdIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// skip safety check
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *OsdIdsRequest) Reset() {
+	*x = OsdIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_osd_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsdIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsdIdsRequest) ProtoMessage() {}
+
+func (x *OsdIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_osd_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OsdIdsRequest.ProtoReflect.Descriptor instead.
+func (*OsdIdsRequest) Descriptor() ([]byte, []int) {
+	return file_osd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OsdIdsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *OsdIdsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type OsdIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// skip safety check
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *OsdIdRequest) Reset() {
+	*x = OsdIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_osd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsdIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsdIdRequest) ProtoMessage() {}
+
+func (x *OsdIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_osd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OsdIdRequest.ProtoReflect.Descriptor instead.
+func (*OsdIdRequest) Descriptor() ([]byte, []int) {
+	return file_osd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OsdIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OsdIdRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReweightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// override weight in range [0.0, 1.0]
+	Weight float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *ReweightRequest) Reset() {
+	*x = ReweightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_osd_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReweightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReweightRequest) ProtoMessage() {}
+
+func (x *ReweightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_osd_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReweightRequest.ProtoReflect.Descriptor instead.
+func (*ReweightRequest) Descriptor() ([]byte, []int) {
+	return file_osd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReweightRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReweightRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SetDeviceClassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// device class, e.g: "hdd", "ssd", "nvme"
+	DeviceClass string `protobuf:"bytes,2,opt,name=device_class,proto3" json:"device_class,omitempty"`
+}
+
+func (x *SetDeviceClassRequest) Reset() {
+	*x = SetDeviceClassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_osd_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDeviceClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeviceClassRequest) ProtoMessage() {}
+
+func (x *SetDeviceClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_osd_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeviceClassRequest.ProtoReflect.Descriptor instead.
+func (*SetDeviceClassRequest) Descriptor() ([]byte, []int) {
+	return file_osd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetDeviceClassRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SetDeviceClassRequest) GetDeviceClass() string {
+	if x != nil {
+		return x.DeviceClass
+	}
+	return ""
+}
+
+var File_osd_proto protoreflect.FileDescriptor
+
+var file_osd_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6f, 0x73, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x65, 0x70,
+	0x68, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x37, 0x0a, 0x0d, 0x4f, 0x73, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x0c, 0x4f, 0x73, 0x64,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
+	0x39, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x32, 0x8d, 0x03, 0x0a, 0x03, 0x4f, 0x73,
+	0x64, 0x12, 0x35, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x65,
+	0x70, 0x68, 0x2e, 0x4f, 0x73, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x4d, 0x61, 0x72, 0x6b,
+	0x4f, 0x75, 0x74, 0x12, 0x13, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x4f, 0x73, 0x64, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x37, 0x0a, 0x08, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x13, 0x2e, 0x63,
+	0x65, 0x70, 0x68, 0x2e, 0x4f, 0x73, 0x64, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x52, 0x65, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x52, 0x65, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x4f, 0x73,
+	0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x65, 0x70,
+	0x68, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e, 0x4f, 0x73, 0x64, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x65, 0x70, 0x68, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x79, 0x73, 0x6f, 0x2f, 0x63, 0x65,
+	0x70, 0x68, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x70, 0x68, 0x3b,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_osd_proto_rawDescOnce sync.Once
+	file_osd_proto_rawDescData = file_osd_proto_rawDesc
+)
+
+func file_osd_proto_rawDescGZIP() []byte {
+	file_osd_proto_rawDescOnce.Do(func() {
+		file_osd_proto_rawDescData = protoimpl.X.CompressGZIP(file_osd_proto_rawDescData)
+	})
+	return file_osd_proto_rawDescData
+}
+
+var file_osd_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_osd_proto_goTypes = []interface{}{
+	(*OsdIdsRequest)(nil),         // 0: ceph.OsdIdsRequest
+	(*OsdIdRequest)(nil),          // 1: ceph.OsdIdRequest
+	(*ReweightRequest)(nil),       // 2: ceph.ReweightRequest
+	(*SetDeviceClassRequest)(nil), // 3: ceph.SetDeviceClassRequest
+	(*emptypb.Empty)(nil),         // 4: google.protobuf.Empty
+	(*Operation)(nil),             // 5: ceph.Operation
+}
+var file_osd_proto_depIdxs = []int32{
+	0, // 0: ceph.Osd.MarkIn:input_type -> ceph.OsdIdsRequest
+	0, // 1: ceph.Osd.MarkOut:input_type -> ceph.OsdIdsRequest
+	0, // 2: ceph.Osd.MarkDown:input_type -> ceph.OsdIdsRequest
+	2, // 3: ceph.Osd.Reweight:input_type -> ceph.ReweightRequest
+	3, // 4: ceph.Osd.SetDeviceClass:input_type -> ceph.SetDeviceClassRequest
+	1, // 5: ceph.Osd.Destroy:input_type -> ceph.OsdIdRequest
+	1, // 6: ceph.Osd.Purge:input_type -> ceph.OsdIdRequest
+	4, // 7: ceph.Osd.MarkIn:output_type -> google.protobuf.Empty
+	4, // 8: ceph.Osd.MarkOut:output_type -> google.protobuf.Empty
+	4, // 9: ceph.Osd.MarkDown:output_type -> google.protobuf.Empty
+	4, // 10: ceph.Osd.Reweight:output_type -> google.protobuf.Empty
+	4, // 11: ceph.Osd.SetDeviceClass:output_type -> google.protobuf.Empty
+	5, // 12: ceph.Osd.Destroy:output_type -> ceph.Operation
+	5, // 13: ceph.Osd.Purge:output_type -> ceph.Operation
+	7, // [7:14] is the sub-list for method output_type
+	0, // [0:7] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_osd_proto_init() }
+func file_osd_proto_init() {
+	if File_osd_proto != nil {
+		return
+	}
+	file_operations_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_osd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OsdIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_osd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OsdIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_osd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReweightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_osd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDeviceClassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_osd_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_osd_proto_goTypes,
+		DependencyIndexes: file_osd_proto_depIdxs,
+		MessageInfos:      file_osd_proto_msgTypes,
+	}.Build()
+	File_osd_proto = out.File
+	file_osd_proto_rawDesc = nil
+	file_osd_proto_goTypes = nil
+	file_osd_proto_depIdxs = nil
+}
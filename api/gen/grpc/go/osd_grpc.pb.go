@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: osd.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Osd_MarkIn_FullMethodName         = "/ceph.Osd/MarkIn"
+	Osd_MarkOut_FullMethodName        = "/ceph.Osd/MarkOut"
+	Osd_MarkDown_FullMethodName       = "/ceph.Osd/MarkDown"
+	Osd_Reweight_FullMethodName       = "/ceph.Osd/Reweight"
+	Osd_SetDeviceClass_FullMethodName = "/ceph.Osd/SetDeviceClass"
+	Osd_Destroy_FullMethodName        = "/ceph.Osd/Destroy"
+	Osd_Purge_FullMethodName          = "/ceph.Osd/Purge"
+)
+
+// OsdClient is the client API for Osd service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OsdClient interface {
+	// command: ceph osd in
+	MarkIn(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd out. Checks ceph osd ok-to-stop unless forced.
+	MarkOut(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd down. Checks ceph osd ok-to-stop unless forced.
+	MarkDown(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd reweight
+	Reweight(ctx context.Context, in *ReweightRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd crush rm-device-class / ceph osd crush set-device-class
+	SetDeviceClass(ctx context.Context, in *SetDeviceClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd destroy. Checks ceph osd safe-to-destroy unless forced.
+	// Returns long-running operation, see Operations service.
+	Destroy(ctx context.Context, in *OsdIdRequest, opts ...grpc.CallOption) (*Operation, error)
+	// command: ceph osd purge. Checks ceph osd safe-to-destroy unless forced.
+	// Returns long-running operation, see Operations service.
+	Purge(ctx context.Context, in *OsdIdRequest, opts ...grpc.CallOption) (*Operation, error)
+}
+
+type osdClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOsdClient(cc grpc.ClientConnInterface) OsdClient {
+	return &osdClient{cc}
+}
+
+func (c *osdClient) MarkIn(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Osd_MarkIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) MarkOut(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Osd_MarkOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) MarkDown(ctx context.Context, in *OsdIdsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Osd_MarkDown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) Reweight(ctx context.Context, in *ReweightRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Osd_Reweight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) SetDeviceClass(ctx context.Context, in *SetDeviceClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Osd_SetDeviceClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) Destroy(ctx context.Context, in *OsdIdRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, Osd_Destroy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *osdClient) Purge(ctx context.Context, in *OsdIdRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, Osd_Purge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OsdServer is the server API for Osd service.
+// All implementations should embed UnimplementedOsdServer
+// for forward compatibility.
+type OsdServer interface {
+	// command: ceph osd in
+	MarkIn(context.Context, *OsdIdsRequest) (*emptypb.Empty, error)
+	// command: ceph osd out. Checks ceph osd ok-to-stop unless forced.
+	MarkOut(context.Context, *OsdIdsRequest) (*emptypb.Empty, error)
+	// command: ceph osd down. Checks ceph osd ok-to-stop unless forced.
+	MarkDown(context.Context, *OsdIdsRequest) (*emptypb.Empty, error)
+	// command: ceph osd reweight
+	Reweight(context.Context, *ReweightRequest) (*emptypb.Empty, error)
+	// command: ceph osd crush rm-device-class / ceph osd crush set-device-class
+	SetDeviceClass(context.Context, *SetDeviceClassRequest) (*emptypb.Empty, error)
+	// command: ceph osd destroy. Checks ceph osd safe-to-destroy unless forced.
+	// Returns long-running operation, see Operations service.
+	Destroy(context.Context, *OsdIdRequest) (*Operation, error)
+	// command: ceph osd purge. Checks ceph osd safe-to-destroy unless forced.
+	// Returns long-running operation, see Operations service.
+	Purge(context.Context, *OsdIdRequest) (*Operation, error)
+}
+
+// UnimplementedOsdServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOsdServer struct{}
+
+func (UnimplementedOsdServer) MarkIn(context.Context, *OsdIdsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkIn not implemented")
+}
+func (UnimplementedOsdServer) MarkOut(context.Context, *OsdIdsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOut not implemented")
+}
+func (UnimplementedOsdServer) MarkDown(context.Context, *OsdIdsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDown not implemented")
+}
+func (UnimplementedOsdServer) Reweight(context.Context, *ReweightRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reweight not implemented")
+}
+func (UnimplementedOsdServer) SetDeviceClass(context.Context, *SetDeviceClassRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviceClass not implemented")
+}
+func (UnimplementedOsdServer) Destroy(context.Context, *OsdIdRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Destroy not implemented")
+}
+func (UnimplementedOsdServer) Purge(context.Context, *OsdIdRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
+func (UnimplementedOsdServer) testEmbeddedByValue() {}
+
+// UnsafeOsdServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OsdServer will
+// result in compilation errors.
+type UnsafeOsdServer interface {
+	mustEmbedUnimplementedOsdServer()
+}
+
+func RegisterOsdServer(s grpc.ServiceRegistrar, srv OsdServer) {
+	// If the following call pancis, it indicates UnimplementedOsdServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Osd_ServiceDesc, srv)
+}
+
+func _Osd_MarkIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OsdIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).MarkIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_MarkIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).MarkIn(ctx, req.(*OsdIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_MarkOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OsdIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).MarkOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_MarkOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).MarkOut(ctx, req.(*OsdIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_MarkDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OsdIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).MarkDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_MarkDown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).MarkDown(ctx, req.(*OsdIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_Reweight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReweightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).Reweight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_Reweight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).Reweight(ctx, req.(*ReweightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_SetDeviceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).SetDeviceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_SetDeviceClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).SetDeviceClass(ctx, req.(*SetDeviceClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_Destroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OsdIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).Destroy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_Destroy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).Destroy(ctx, req.(*OsdIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Osd_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OsdIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OsdServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Osd_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OsdServer).Purge(ctx, req.(*OsdIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Osd_ServiceDesc is the grpc.ServiceDesc for Osd service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Osd_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ceph.Osd",
+	HandlerType: (*OsdServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarkIn",
+			Handler:    _Osd_MarkIn_Handler,
+		},
+		{
+			MethodName: "MarkOut",
+			Handler:    _Osd_MarkOut_Handler,
+		},
+		{
+			MethodName: "MarkDown",
+			Handler:    _Osd_MarkDown_Handler,
+		},
+		{
+			MethodName: "Reweight",
+			Handler:    _Osd_Reweight_Handler,
+		},
+		{
+			MethodName: "SetDeviceClass",
+			Handler:    _Osd_SetDeviceClass_Handler,
+		},
+		{
+			MethodName: "Destroy",
+			Handler:    _Osd_Destroy_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _Osd_Purge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osd.proto",
+}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pool.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Pool_ListPools_FullMethodName  = "/ceph.Pool/ListPools"
+	Pool_GetPool_FullMethodName    = "/ceph.Pool/GetPool"
+	Pool_CreatePool_FullMethodName = "/ceph.Pool/CreatePool"
+	Pool_UpdatePool_FullMethodName = "/ceph.Pool/UpdatePool"
+	Pool_DeletePool_FullMethodName = "/ceph.Pool/DeletePool"
+)
+
+// PoolClient is the client API for Pool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PoolClient interface {
+	// command: ceph osd dump
+	ListPools(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPoolsResponse, error)
+	GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*OsdDumpPool, error)
+	// command: ceph osd pool create
+	CreatePool(ctx context.Context, in *CreatePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd pool set / ceph osd pool application enable
+	UpdatePool(ctx context.Context, in *UpdatePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// command: ceph osd pool delete. Returns long-running operation, see Operations service.
+	DeletePool(ctx context.Context, in *DeletePoolRequest, opts ...grpc.CallOption) (*Operation, error)
+}
+
+type poolClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPoolClient(cc grpc.ClientConnInterface) PoolClient {
+	return &poolClient{cc}
+}
+
+func (c *poolClient) ListPools(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoolsResponse)
+	err := c.cc.Invoke(ctx, Pool_ListPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolClient) GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*OsdDumpPool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OsdDumpPool)
+	err := c.cc.Invoke(ctx, Pool_GetPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolClient) CreatePool(ctx context.Context, in *CreatePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Pool_CreatePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolClient) UpdatePool(ctx context.Context, in *UpdatePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Pool_UpdatePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolClient) DeletePool(ctx context.Context, in *DeletePoolRequest, opts ...grpc.CallOption) (*Operation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, Pool_DeletePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PoolServer is the server API for Pool service.
+// All implementations should embed UnimplementedPoolServer
+// for forward compatibility.
+type PoolServer interface {
+	// command: ceph osd dump
+	ListPools(context.Context, *emptypb.Empty) (*ListPoolsResponse, error)
+	GetPool(context.Context, *GetPoolRequest) (*OsdDumpPool, error)
+	// command: ceph osd pool create
+	CreatePool(context.Context, *CreatePoolRequest) (*emptypb.Empty, error)
+	// command: ceph osd pool set / ceph osd pool application enable
+	UpdatePool(context.Context, *UpdatePoolRequest) (*emptypb.Empty, error)
+	// command: ceph osd pool delete. Returns long-running operation, see Operations service.
+	DeletePool(context.Context, *DeletePoolRequest) (*Operation, error)
+}
+
+// UnimplementedPoolServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPoolServer struct{}
+
+func (UnimplementedPoolServer) ListPools(context.Context, *emptypb.Empty) (*ListPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
+}
+func (UnimplementedPoolServer) GetPool(context.Context, *GetPoolRequest) (*OsdDumpPool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
+}
+func (UnimplementedPoolServer) CreatePool(context.Context, *CreatePoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (UnimplementedPoolServer) UpdatePool(context.Context, *UpdatePoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePool not implemented")
+}
+func (UnimplementedPoolServer) DeletePool(context.Context, *DeletePoolRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePool not implemented")
+}
+func (UnimplementedPoolServer) testEmbeddedByValue() {}
+
+// UnsafePoolServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PoolServer will
+// result in compilation errors.
+type UnsafePoolServer interface {
+	mustEmbedUnimplementedPoolServer()
+}
+
+func RegisterPoolServer(s grpc.ServiceRegistrar, srv PoolServer) {
+	// If the following call pancis, it indicates UnimplementedPoolServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Pool_ServiceDesc, srv)
+}
+
+func _Pool_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pool_ListPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).ListPools(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pool_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).GetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pool_GetPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).GetPool(ctx, req.(*GetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pool_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pool_CreatePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).CreatePool(ctx, req.(*CreatePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pool_UpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).UpdatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pool_UpdatePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).UpdatePool(ctx, req.(*UpdatePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pool_DeletePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServer).DeletePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pool_DeletePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServer).DeletePool(ctx, req.(*DeletePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Pool_ServiceDesc is the grpc.ServiceDesc for Pool service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pool_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ceph.Pool",
+	HandlerType: (*PoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPools",
+			Handler:    _Pool_ListPools_Handler,
+		},
+		{
+			MethodName: "GetPool",
+			Handler:    _Pool_GetPool_Handler,
+		},
+		{
+			MethodName: "CreatePool",
+			Handler:    _Pool_CreatePool_Handler,
+		},
+		{
+			MethodName: "UpdatePool",
+			Handler:    _Pool_UpdatePool_Handler,
+		},
+		{
+			MethodName: "DeletePool",
+			Handler:    _Pool_DeletePool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pool.proto",
+}
@@ -54,6 +54,23 @@ func local_request_Status_GetCephStatus_0(ctx context.Context, marshaler runtime
 	return msg, metadata, err
 }
 
+func request_Status_WatchCephStatus_0(ctx context.Context, marshaler runtime.Marshaler, client StatusClient, req *http.Request, pathParams map[string]string) (Status_WatchCephStatusClient, runtime.ServerMetadata, error) {
+	var (
+		protoReq emptypb.Empty
+		metadata runtime.ServerMetadata
+	)
+	stream, err := client.WatchCephStatus(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
 func request_Status_GetCephMonDump_0(ctx context.Context, marshaler runtime.Marshaler, client StatusClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq emptypb.Empty
@@ -134,6 +151,13 @@ func RegisterStatusHandlerServer(ctx context.Context, mux *runtime.ServeMux, ser
 		}
 		forward_Status_GetCephStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+
+	mux.Handle(http.MethodGet, pattern_Status_WatchCephStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
 	mux.Handle(http.MethodGet, pattern_Status_GetCephMonDump_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -251,6 +275,23 @@ func RegisterStatusHandlerClient(ctx context.Context, mux *runtime.ServeMux, cli
 		}
 		forward_Status_GetCephStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_Status_WatchCephStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/ceph.Status/WatchCephStatus", runtime.WithHTTPPathPattern("/api/status/ceph/watch"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Status_WatchCephStatus_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Status_WatchCephStatus_0(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_Status_GetCephMonDump_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -306,15 +347,17 @@ func RegisterStatusHandlerClient(ctx context.Context, mux *runtime.ServeMux, cli
 }
 
 var (
-	pattern_Status_GetCephStatus_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "ceph"}, ""))
-	pattern_Status_GetCephMonDump_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "mon_dump"}, ""))
-	pattern_Status_GetCephOsdDump_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "osd_dump"}, ""))
-	pattern_Status_GetCephReport_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "report"}, ""))
+	pattern_Status_GetCephStatus_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "ceph"}, ""))
+	pattern_Status_WatchCephStatus_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "status", "ceph", "watch"}, ""))
+	pattern_Status_GetCephMonDump_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "mon_dump"}, ""))
+	pattern_Status_GetCephOsdDump_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "osd_dump"}, ""))
+	pattern_Status_GetCephReport_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "status", "report"}, ""))
 )
 
 var (
-	forward_Status_GetCephStatus_0  = runtime.ForwardResponseMessage
-	forward_Status_GetCephMonDump_0 = runtime.ForwardResponseMessage
-	forward_Status_GetCephOsdDump_0 = runtime.ForwardResponseMessage
-	forward_Status_GetCephReport_0  = runtime.ForwardResponseMessage
+	forward_Status_GetCephStatus_0   = runtime.ForwardResponseMessage
+	forward_Status_WatchCephStatus_0 = runtime.ForwardResponseStream
+	forward_Status_GetCephMonDump_0  = runtime.ForwardResponseMessage
+	forward_Status_GetCephOsdDump_0  = runtime.ForwardResponseMessage
+	forward_Status_GetCephReport_0   = runtime.ForwardResponseMessage
 )
@@ -21,11 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Status_GetCephStatus_FullMethodName  = "/ceph.Status/GetCephStatus"
-	Status_GetCephMonDump_FullMethodName = "/ceph.Status/GetCephMonDump"
-	Status_GetCephOsdDump_FullMethodName = "/ceph.Status/GetCephOsdDump"
-	Status_GetCephPgDump_FullMethodName  = "/ceph.Status/GetCephPgDump"
-	Status_GetCephReport_FullMethodName  = "/ceph.Status/GetCephReport"
+	Status_GetCephStatus_FullMethodName   = "/ceph.Status/GetCephStatus"
+	Status_WatchCephStatus_FullMethodName = "/ceph.Status/WatchCephStatus"
+	Status_GetCephMonDump_FullMethodName  = "/ceph.Status/GetCephMonDump"
+	Status_GetCephOsdDump_FullMethodName  = "/ceph.Status/GetCephOsdDump"
+	Status_GetCephPgDump_FullMethodName   = "/ceph.Status/GetCephPgDump"
+	Status_GetCephReport_FullMethodName   = "/ceph.Status/GetCephReport"
 )
 
 // StatusClient is the client API for Status service.
@@ -34,6 +35,9 @@ const (
 type StatusClient interface {
 	// command: ceph status
 	GetCephStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCephStatusResponse, error)
+	// Streams ceph status. Sends current status on subscribe and then
+	// every time cluster health, pg states, osd or mon map changes.
+	WatchCephStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCephStatusResponse], error)
 	// command: ceph mon dump
 	GetCephMonDump(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CephMonDumpResponse, error)
 	// command: ceph osd dump
@@ -62,6 +66,25 @@ func (c *statusClient) GetCephStatus(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *statusClient) WatchCephStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetCephStatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Status_ServiceDesc.Streams[0], Status_WatchCephStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, GetCephStatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Status_WatchCephStatusClient = grpc.ServerStreamingClient[GetCephStatusResponse]
+
 func (c *statusClient) GetCephMonDump(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CephMonDumpResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CephMonDumpResponse)
@@ -108,6 +131,9 @@ func (c *statusClient) GetCephReport(ctx context.Context, in *emptypb.Empty, opt
 type StatusServer interface {
 	// command: ceph status
 	GetCephStatus(context.Context, *emptypb.Empty) (*GetCephStatusResponse, error)
+	// Streams ceph status. Sends current status on subscribe and then
+	// every time cluster health, pg states, osd or mon map changes.
+	WatchCephStatus(*emptypb.Empty, grpc.ServerStreamingServer[GetCephStatusResponse]) error
 	// command: ceph mon dump
 	GetCephMonDump(context.Context, *emptypb.Empty) (*CephMonDumpResponse, error)
 	// command: ceph osd dump
@@ -128,6 +154,9 @@ type UnimplementedStatusServer struct{}
 func (UnimplementedStatusServer) GetCephStatus(context.Context, *emptypb.Empty) (*GetCephStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCephStatus not implemented")
 }
+func (UnimplementedStatusServer) WatchCephStatus(*emptypb.Empty, grpc.ServerStreamingServer[GetCephStatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCephStatus not implemented")
+}
 func (UnimplementedStatusServer) GetCephMonDump(context.Context, *emptypb.Empty) (*CephMonDumpResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCephMonDump not implemented")
 }
@@ -178,6 +207,17 @@ func _Status_GetCephStatus_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Status_WatchCephStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatusServer).WatchCephStatus(m, &grpc.GenericServerStream[emptypb.Empty, GetCephStatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Status_WatchCephStatusServer = grpc.ServerStreamingServer[GetCephStatusResponse]
+
 func _Status_GetCephMonDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -278,6 +318,12 @@ var Status_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Status_GetCephReport_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCephStatus",
+			Handler:       _Status_WatchCephStatus_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "status.proto",
 }
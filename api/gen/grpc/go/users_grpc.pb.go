@@ -20,18 +20,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Users_ListUsers_FullMethodName          = "/ceph.Users/ListUsers"
-	Users_GetUser_FullMethodName            = "/ceph.Users/GetUser"
-	Users_CreateUser_FullMethodName         = "/ceph.Users/CreateUser"
-	Users_DeleteUser_FullMethodName         = "/ceph.Users/DeleteUser"
-	Users_UpdateUser_FullMethodName         = "/ceph.Users/UpdateUser"
-	Users_UserChangePassword_FullMethodName = "/ceph.Users/UserChangePassword"
-	Users_ListRoles_FullMethodName          = "/ceph.Users/ListRoles"
-	Users_GetRole_FullMethodName            = "/ceph.Users/GetRole"
-	Users_CreateRole_FullMethodName         = "/ceph.Users/CreateRole"
-	Users_DeleteRole_FullMethodName         = "/ceph.Users/DeleteRole"
-	Users_UpdateRole_FullMethodName         = "/ceph.Users/UpdateRole"
-	Users_CloneRole_FullMethodName          = "/ceph.Users/CloneRole"
+	Users_ListUsers_FullMethodName               = "/ceph.Users/ListUsers"
+	Users_GetUser_FullMethodName                 = "/ceph.Users/GetUser"
+	Users_CreateUser_FullMethodName              = "/ceph.Users/CreateUser"
+	Users_DeleteUser_FullMethodName              = "/ceph.Users/DeleteUser"
+	Users_UpdateUser_FullMethodName              = "/ceph.Users/UpdateUser"
+	Users_UserChangePassword_FullMethodName      = "/ceph.Users/UserChangePassword"
+	Users_EnrollTOTP_FullMethodName              = "/ceph.Users/EnrollTOTP"
+	Users_ActivateTOTP_FullMethodName            = "/ceph.Users/ActivateTOTP"
+	Users_RegenerateRecoveryCodes_FullMethodName = "/ceph.Users/RegenerateRecoveryCodes"
+	Users_DisableTOTP_FullMethodName             = "/ceph.Users/DisableTOTP"
+	Users_ListRoles_FullMethodName               = "/ceph.Users/ListRoles"
+	Users_GetRole_FullMethodName                 = "/ceph.Users/GetRole"
+	Users_CreateRole_FullMethodName              = "/ceph.Users/CreateRole"
+	Users_DeleteRole_FullMethodName              = "/ceph.Users/DeleteRole"
+	Users_UpdateRole_FullMethodName              = "/ceph.Users/UpdateRole"
+	Users_CloneRole_FullMethodName               = "/ceph.Users/CloneRole"
+	Users_GetEffectivePermissions_FullMethodName = "/ceph.Users/GetEffectivePermissions"
+	Users_CanI_FullMethodName                    = "/ceph.Users/CanI"
+	Users_ListServiceAccounts_FullMethodName     = "/ceph.Users/ListServiceAccounts"
+	Users_GetServiceAccount_FullMethodName       = "/ceph.Users/GetServiceAccount"
+	Users_CreateServiceAccount_FullMethodName    = "/ceph.Users/CreateServiceAccount"
+	Users_DeleteServiceAccount_FullMethodName    = "/ceph.Users/DeleteServiceAccount"
+	Users_CreateAPIKey_FullMethodName            = "/ceph.Users/CreateAPIKey"
+	Users_ListAPIKeys_FullMethodName             = "/ceph.Users/ListAPIKeys"
+	Users_RevokeAPIKey_FullMethodName            = "/ceph.Users/RevokeAPIKey"
 )
 
 // UsersClient is the client API for Users service.
@@ -44,12 +57,27 @@ type UsersClient interface {
 	DeleteUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UserChangePassword(ctx context.Context, in *UserChangePasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	EnrollTOTP(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ActivateTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	DisableTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolesResp, error)
 	GetRole(ctx context.Context, in *GetRoleReq, opts ...grpc.CallOption) (*Role, error)
 	CreateRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRole(ctx context.Context, in *GetRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateRole(ctx context.Context, in *Role, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CloneRole(ctx context.Context, in *CloneRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// permissions granted to user or service account with roles granting them
+	GetEffectivePermissions(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*EffectivePermissionsResp, error)
+	// explains if permission is granted to the caller
+	CanI(ctx context.Context, in *CanIReq, opts ...grpc.CallOption) (*CanIResp, error)
+	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceAccountsResp, error)
+	GetServiceAccount(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*ServiceAccount, error)
+	CreateServiceAccount(ctx context.Context, in *ServiceAccount, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteServiceAccount(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResp, error)
+	ListAPIKeys(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*APIKeysResp, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type usersClient struct {
@@ -120,6 +148,46 @@ func (c *usersClient) UserChangePassword(ctx context.Context, in *UserChangePass
 	return out, nil
 }
 
+func (c *usersClient) EnrollTOTP(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, Users_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ActivateTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Users_ActivateTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Users_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DisableTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RolesResp)
@@ -180,6 +248,96 @@ func (c *usersClient) CloneRole(ctx context.Context, in *CloneRoleReq, opts ...g
 	return out, nil
 }
 
+func (c *usersClient) GetEffectivePermissions(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*EffectivePermissionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectivePermissionsResp)
+	err := c.cc.Invoke(ctx, Users_GetEffectivePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CanI(ctx context.Context, in *CanIReq, opts ...grpc.CallOption) (*CanIResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanIResp)
+	err := c.cc.Invoke(ctx, Users_CanI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceAccountsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountsResp)
+	err := c.cc.Invoke(ctx, Users_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetServiceAccount(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*ServiceAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccount)
+	err := c.cc.Invoke(ctx, Users_GetServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CreateServiceAccount(ctx context.Context, in *ServiceAccount, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) DeleteServiceAccount(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResp)
+	err := c.cc.Invoke(ctx, Users_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) ListAPIKeys(ctx context.Context, in *GetServiceAccountReq, opts ...grpc.CallOption) (*APIKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeysResp)
+	err := c.cc.Invoke(ctx, Users_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Users_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServer is the server API for Users service.
 // All implementations should embed UnimplementedUsersServer
 // for forward compatibility.
@@ -190,12 +348,27 @@ type UsersServer interface {
 	DeleteUser(context.Context, *GetUserReq) (*emptypb.Empty, error)
 	UpdateUser(context.Context, *CreateUserReq) (*emptypb.Empty, error)
 	UserChangePassword(context.Context, *UserChangePasswordReq) (*emptypb.Empty, error)
+	EnrollTOTP(context.Context, *GetUserReq) (*EnrollTOTPResp, error)
+	ActivateTOTP(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error)
+	RegenerateRecoveryCodes(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error)
+	DisableTOTP(context.Context, *TOTPCodeReq) (*emptypb.Empty, error)
 	ListRoles(context.Context, *emptypb.Empty) (*RolesResp, error)
 	GetRole(context.Context, *GetRoleReq) (*Role, error)
 	CreateRole(context.Context, *Role) (*emptypb.Empty, error)
 	DeleteRole(context.Context, *GetRoleReq) (*emptypb.Empty, error)
 	UpdateRole(context.Context, *Role) (*emptypb.Empty, error)
 	CloneRole(context.Context, *CloneRoleReq) (*emptypb.Empty, error)
+	// permissions granted to user or service account with roles granting them
+	GetEffectivePermissions(context.Context, *GetUserReq) (*EffectivePermissionsResp, error)
+	// explains if permission is granted to the caller
+	CanI(context.Context, *CanIReq) (*CanIResp, error)
+	ListServiceAccounts(context.Context, *emptypb.Empty) (*ServiceAccountsResp, error)
+	GetServiceAccount(context.Context, *GetServiceAccountReq) (*ServiceAccount, error)
+	CreateServiceAccount(context.Context, *ServiceAccount) (*emptypb.Empty, error)
+	DeleteServiceAccount(context.Context, *GetServiceAccountReq) (*emptypb.Empty, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResp, error)
+	ListAPIKeys(context.Context, *GetServiceAccountReq) (*APIKeysResp, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*emptypb.Empty, error)
 }
 
 // UnimplementedUsersServer should be embedded to have
@@ -223,6 +396,18 @@ func (UnimplementedUsersServer) UpdateUser(context.Context, *CreateUserReq) (*em
 func (UnimplementedUsersServer) UserChangePassword(context.Context, *UserChangePasswordReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserChangePassword not implemented")
 }
+func (UnimplementedUsersServer) EnrollTOTP(context.Context, *GetUserReq) (*EnrollTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedUsersServer) ActivateTOTP(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateTOTP not implemented")
+}
+func (UnimplementedUsersServer) RegenerateRecoveryCodes(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUsersServer) DisableTOTP(context.Context, *TOTPCodeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
 func (UnimplementedUsersServer) ListRoles(context.Context, *emptypb.Empty) (*RolesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
 }
@@ -241,6 +426,33 @@ func (UnimplementedUsersServer) UpdateRole(context.Context, *Role) (*emptypb.Emp
 func (UnimplementedUsersServer) CloneRole(context.Context, *CloneRoleReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloneRole not implemented")
 }
+func (UnimplementedUsersServer) GetEffectivePermissions(context.Context, *GetUserReq) (*EffectivePermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePermissions not implemented")
+}
+func (UnimplementedUsersServer) CanI(context.Context, *CanIReq) (*CanIResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanI not implemented")
+}
+func (UnimplementedUsersServer) ListServiceAccounts(context.Context, *emptypb.Empty) (*ServiceAccountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedUsersServer) GetServiceAccount(context.Context, *GetServiceAccountReq) (*ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccount not implemented")
+}
+func (UnimplementedUsersServer) CreateServiceAccount(context.Context, *ServiceAccount) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedUsersServer) DeleteServiceAccount(context.Context, *GetServiceAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedUsersServer) CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUsersServer) ListAPIKeys(context.Context, *GetServiceAccountReq) (*APIKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedUsersServer) RevokeAPIKey(context.Context, *RevokeAPIKeyReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedUsersServer) testEmbeddedByValue() {}
 
 // UnsafeUsersServer may be embedded to opt out of forward compatibility for this service.
@@ -369,6 +581,78 @@ func _Users_UserChangePassword_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).EnrollTOTP(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ActivateTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ActivateTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ActivateTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ActivateTOTP(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RegenerateRecoveryCodes(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).DisableTOTP(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Users_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -477,6 +761,168 @@ func _Users_CloneRole_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_GetEffectivePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetEffectivePermissions(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_CanI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanIReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CanI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_CanI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CanI(ctx, req.(*CanIReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListServiceAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_GetServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetServiceAccount(ctx, req.(*GetServiceAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CreateServiceAccount(ctx, req.(*ServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).DeleteServiceAccount(ctx, req.(*GetServiceAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).CreateAPIKey(ctx, req.(*CreateAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).ListAPIKeys(ctx, req.(*GetServiceAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Users_ServiceDesc is the grpc.ServiceDesc for Users service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -508,6 +954,22 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserChangePassword",
 			Handler:    _Users_UserChangePassword_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Users_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ActivateTOTP",
+			Handler:    _Users_ActivateTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Users_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Users_DisableTOTP_Handler,
+		},
 		{
 			MethodName: "ListRoles",
 			Handler:    _Users_ListRoles_Handler,
@@ -532,6 +994,42 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloneRole",
 			Handler:    _Users_CloneRole_Handler,
 		},
+		{
+			MethodName: "GetEffectivePermissions",
+			Handler:    _Users_GetEffectivePermissions_Handler,
+		},
+		{
+			MethodName: "CanI",
+			Handler:    _Users_CanI_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _Users_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _Users_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _Users_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _Users_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Users_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Users_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Users_RevokeAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
@@ -20,17 +20,13 @@ import (
 	"google.golang.org/grpc"
 )
 
-func Serve(ctx context.Context, conf Config, grpcServer *grpc.Server, httpServer http.Handler) (start func(context.Context) error, stop func(context.Context) error, err error) {
+func Serve(ctx context.Context, conf Config, certs *TLSCerts, grpcServer *grpc.Server, httpServer http.Handler) (start func(context.Context) error, stop func(context.Context) error, err error) {
 	if conf.GrpcPort == conf.HttpPort {
 		// Serve grpc and http on the same port
 		zerolog.Ctx(ctx).Info().Msgf("serving grpc and http API on the same port %d", conf.HttpPort)
 		var lis net.Listener
 		if conf.Secure {
-			tlsConf, err := selfIssuedTlsConf()
-			if err != nil {
-				return nil, nil, err
-			}
-			lis, err = tls.Listen("tcp", fmt.Sprintf(":%d", conf.HttpPort), tlsConf)
+			lis, err = tls.Listen("tcp", fmt.Sprintf(":%d", conf.HttpPort), certs.ServerConfig(true))
 		} else {
 			lis, err = net.Listen("tcp", fmt.Sprintf(":%d", conf.HttpPort))
 		}
@@ -92,7 +88,6 @@ func Serve(ctx context.Context, conf Config, grpcServer *grpc.Server, httpServer
 		return
 	}
 	// Serve http and grpc on different ports
-	// TODO: support TLS
 	srv := &http.Server{Addr: fmt.Sprintf("0.0.0.0:%d", conf.HttpPort)}
 	srv.Handler = httpServer
 
@@ -100,11 +95,22 @@ func Serve(ctx context.Context, conf Config, grpcServer *grpc.Server, httpServer
 	if err != nil {
 		return nil, nil, err
 	}
+	if conf.Secure {
+		// client certificates are used only by grpc
+		srv.TLSConfig = certs.ServerConfig(false)
+		grpcLis = tls.NewListener(grpcLis, certs.ServerConfig(true))
+	}
 
 	start = func(ctx context.Context) error {
 		g, gCtx := errgroup.WithContext(ctx)
 		g.Go(func() error {
-			err := srv.ListenAndServe()
+			var err error
+			if conf.Secure {
+				// certificates are already set in srv.TLSConfig
+				err = srv.ListenAndServeTLS("", "")
+			} else {
+				err = srv.ListenAndServe()
+			}
 			if err != nil && !errors.Is(err, http.ErrServerClosed) {
 				zerolog.Ctx(gCtx).Err(err).Msg("unable to start http server")
 			}
@@ -131,17 +137,6 @@ func Serve(ctx context.Context, conf Config, grpcServer *grpc.Server, httpServer
 	return
 }
 
-func selfIssuedTlsConf() (*tls.Config, error) {
-	cert, err := genX509KeyPair()
-	if err != nil {
-		return nil, err
-	}
-	tlsConf := &tls.Config{InsecureSkipVerify: true} //nolint: gosec
-	tlsConf.Certificates = make([]tls.Certificate, 1)
-	tlsConf.Certificates[0] = cert
-	return tlsConf, nil
-}
-
 func genX509KeyPair() (tls.Certificate, error) {
 	now := time.Now()
 	template := &x509.Certificate{
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/audit"
+	"github.com/clyso/ceph-api/pkg/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewAuditAPI(auditLog *audit.Log) pb.AuditServer {
+	return &auditAPI{
+		auditLog: auditLog,
+	}
+}
+
+type auditAPI struct {
+	auditLog *audit.Log
+}
+
+func (a *auditAPI) ListEvents(ctx context.Context, req *pb.ListAuditEventsReq) (*pb.ListAuditEventsResp, error) {
+	// audit records show who changed users and credentials, so the same permissions as for user management are required
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead, user.PermCreate, user.PermUpdate, user.PermDelete); err != nil {
+		return nil, err
+	}
+	filter := audit.Filter{
+		Username:  req.GetUsername(),
+		Method:    req.GetMethod(),
+		Limit:     int(req.GetLimit()),
+		PageToken: req.GetPageToken(),
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	entries, next, err := a.auditLog.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListAuditEventsResp{Events: make([]*pb.AuditEvent, len(entries))}
+	for i, e := range entries {
+		res.Events[i] = &pb.AuditEvent{
+			Time:     timestamppb.New(e.Time),
+			Username: e.Username,
+			Method:   e.Method,
+			Request:  string(e.Request),
+			Code:     e.Code,
+			Error:    e.Error,
+			TraceId:  e.TraceID,
+		}
+	}
+	if next != "" {
+		res.NextPageToken = &next
+	}
+	return res, nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/clyso/ceph-api/pkg/auth"
@@ -21,29 +22,47 @@ type authAPI struct {
 }
 
 func (a *authAPI) Check(ctx context.Context, req *pb.TokenCheckReq) (*pb.TokenCheckResp, error) {
-	return nil, types.ErrNotImplemented
+	if req.Token == "" {
+		return nil, fmt.Errorf("%w: token is required", types.ErrInvalidArg)
+	}
+	res, err := a.svc.Check(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TokenCheckResp{
+		Username:          res.User.Username,
+		PwdUpdateRequired: res.User.PwdUpdateRequired,
+		PwdExpirationDate: tsToPb(res.User.PwdExpirationDate),
+		Sso:               res.SSO,
+		Permissions:       permissionsToPb(res.Permissions),
+	}, nil
 }
 
 func (a *authAPI) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginResp, error) {
-	res, err := a.svc.Login(ctx, req.Username, req.Password)
+	res, err := a.svc.Login(ctx, req.Username, req.Password, req.Otp)
 	if err != nil {
 		return nil, err
 	}
-	permissions := make(map[string]*structpb.ListValue, len(res.Permissions))
-	for p, vals := range res.Permissions {
-		permissions[p] = &structpb.ListValue{}
+	return &pb.LoginResp{
+		Token:                res.Token,
+		Username:             res.User.Username,
+		PwdUpdateRequired:    res.User.PwdUpdateRequired,
+		PwdExpirationDate:    tsToPb(res.User.PwdExpirationDate),
+		Sso:                  res.SSO,
+		Permissions:          permissionsToPb(res.Permissions),
+		PwdExpirationWarning: int32(res.PwdExpirationWarning),
+	}, nil
+}
+
+func permissionsToPb(permissions map[string][]string) map[string]*structpb.ListValue {
+	res := make(map[string]*structpb.ListValue, len(permissions))
+	for p, vals := range permissions {
+		res[p] = &structpb.ListValue{}
 		for _, v := range vals {
-			permissions[p].Values = append(permissions[p].Values, structpb.NewStringValue(v))
+			res[p].Values = append(res[p].Values, structpb.NewStringValue(v))
 		}
 	}
-	return &pb.LoginResp{
-		Token:             res.Token,
-		Username:          res.User.Username,
-		PwdUpdateRequired: res.User.PwdUpdateRequired,
-		PwdExpirationDate: tsToPb(res.User.PwdExpirationDate),
-		Sso:               false,
-		Permissions:       permissions,
-	}, nil
+	return res
 }
 
 func (a *authAPI) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
@@ -6,7 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
@@ -15,6 +15,7 @@ import (
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -25,6 +26,9 @@ func NewClusterAPI(radosSvc *rados.Svc, configSvc *cephconfig.Config) pb.Cluster
 	}
 }
 
+// config-key storing cluster status shown by dashboard
+const clusterStatusKey = "mgr/dashboard/cluster/status"
+
 type clusterAPI struct {
 	radosSvc  *rados.Svc
 	configSvc *cephconfig.Config
@@ -34,8 +38,7 @@ func (c *clusterAPI) DeleteUser(ctx context.Context, req *pb.DeleteClusterUserRe
 	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermDelete); err != nil {
 		return nil, err
 	}
-	const monCmdTeml = `{"prefix": "auth del", "entity": "%s"}`
-	monCmd := fmt.Sprintf(monCmdTeml, req.UserEntity)
+	monCmd := rados.MonCmd{Prefix: "auth del", Args: map[string]any{"entity": req.UserEntity}}
 	_, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
@@ -49,11 +52,10 @@ func (c *clusterAPI) ExportUser(ctx context.Context, req *pb.ExportClusterUserRe
 	}
 	buf := bytes.NewBuffer(nil)
 	for _, entity := range req.Entities {
-		const monCmdTeml = `{"prefix": "auth export", "entity": "%s"}`
-		monCmd := fmt.Sprintf(monCmdTeml, entity)
+		monCmd := rados.MonCmd{Prefix: "auth export", Args: map[string]any{"entity": entity}}
 		res, err := c.radosSvc.ExecMon(ctx, monCmd)
 		if err != nil {
-			zerolog.Ctx(ctx).Err(err).Str("mon_cmd", monCmd).Msg("unable to export user")
+			zerolog.Ctx(ctx).Err(err).Str("entity", entity).Msg("unable to export user")
 			continue
 		}
 		buf.Write(res)
@@ -68,7 +70,7 @@ func (c *clusterAPI) CreateUser(ctx context.Context, req *pb.CreateClusterUserRe
 	}
 	if len(req.ImportData) != 0 {
 		zerolog.Ctx(ctx).Debug().Msg("import user data")
-		const monCmd = `{"prefix": "auth import"}`
+		monCmd := rados.MonCmd{Prefix: "auth import"}
 		_, err := c.radosSvc.ExecMonWithInputBuff(ctx, monCmd, req.ImportData)
 		if err != nil {
 			return nil, err
@@ -76,12 +78,10 @@ func (c *clusterAPI) CreateUser(ctx context.Context, req *pb.CreateClusterUserRe
 		return &emptypb.Empty{}, nil
 	}
 
-	const cmdTempl = `{"prefix": "auth add", "entity": "%s", "caps": [%s]}`
-	caps := make([]string, 0, len(req.Capabilities)*2)
-	for k, v := range req.Capabilities {
-		caps = append(caps, strconv.Quote(k), strconv.Quote(v))
-	}
-	monCmd := fmt.Sprintf(cmdTempl, req.UserEntity, strings.Join(caps, ","))
+	monCmd := rados.MonCmd{Prefix: "auth add", Args: map[string]any{
+		"entity": req.UserEntity,
+		"caps":   capsArg(req.Capabilities),
+	}}
 	_, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return nil, err
@@ -94,7 +94,7 @@ func (c *clusterAPI) GetUsers(ctx context.Context, _ *emptypb.Empty) (*pb.Cluste
 	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
 		return nil, err
 	}
-	const monCmd = `{"prefix": "auth ls", "format": "json"}`
+	monCmd := rados.MonCmd{Prefix: "auth ls", Format: "json"}
 
 	cmdRes, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
@@ -115,12 +115,10 @@ func (c *clusterAPI) UpdateUser(ctx context.Context, req *pb.UpdateClusterUserRe
 	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
 		return nil, err
 	}
-	const cmdTempl = `{"prefix": "auth caps", "entity": "%s", "caps": [%s]}`
-	caps := make([]string, 0, len(req.Capabilities)*2)
-	for k, v := range req.Capabilities {
-		caps = append(caps, strconv.Quote(k), strconv.Quote(v))
-	}
-	monCmd := fmt.Sprintf(cmdTempl, req.UserEntity, strings.Join(caps, ","))
+	monCmd := rados.MonCmd{Prefix: "auth caps", Args: map[string]any{
+		"entity": req.UserEntity,
+		"caps":   capsArg(req.Capabilities),
+	}}
 	_, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		if errors.Is(err, types.RadosErrorNotFound) {
@@ -135,7 +133,7 @@ func (c *clusterAPI) GetStatus(ctx context.Context, _ *emptypb.Empty) (*pb.Clust
 	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
 		return nil, err
 	}
-	const monCmd = `{"prefix":"config-key get", "key":"mgr/dashboard/cluster/status"}`
+	monCmd := rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": clusterStatusKey}}
 	cmdRes, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		if errors.Is(err, types.RadosErrorNotFound) {
@@ -153,9 +151,10 @@ func (c *clusterAPI) UpdateStatus(ctx context.Context, req *pb.ClusterStatus) (*
 	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
 		return nil, err
 	}
-	monCmd := fmt.Sprintf(
-		`{"prefix":"config-key set", "key":"mgr/dashboard/cluster/status", "val":"%s"}`,
-		req.Status.String())
+	monCmd := rados.MonCmd{Prefix: "config-key set", Args: map[string]any{
+		"key": clusterStatusKey,
+		"val": req.Status.String(),
+	}}
 
 	_, err := c.radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
@@ -216,3 +215,240 @@ func (c *clusterAPI) SearchConfig(ctx context.Context, req *pb.SearchConfigReque
 		Params: respParams,
 	}, nil
 }
+
+func (c *clusterAPI) GetConfig(ctx context.Context, req *pb.GetConfigRequest) (*pb.GetConfigResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
+		return nil, err
+	}
+	if req.Who == "" || req.Name == "" {
+		return nil, fmt.Errorf("%w: who and name are required", types.ErrInvalidArg)
+	}
+	cmdRes, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config get", Format: "json", Args: map[string]any{
+		"who": req.Who,
+		"key": req.Name,
+	}})
+	if err != nil {
+		if errors.Is(err, types.RadosErrorNotFound) {
+			return nil, fmt.Errorf("%w: config parameter %q not found", types.ErrNotFound, req.Name)
+		}
+		return nil, err
+	}
+	// value is returned as json string for string parameters and as plain text otherwise
+	cmdRes = bytes.TrimSpace(cmdRes)
+	var value string
+	if err := json.Unmarshal(cmdRes, &value); err != nil {
+		value = string(cmdRes)
+	}
+	return &pb.GetConfigResponse{
+		Who:   req.Who,
+		Name:  req.Name,
+		Value: value,
+	}, nil
+}
+
+func (c *clusterAPI) SetConfig(ctx context.Context, req *pb.SetConfigRequest) (*pb.SetConfigResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if req.Who == "" || req.Name == "" {
+		return nil, fmt.Errorf("%w: who and name are required", types.ErrInvalidArg)
+	}
+	res := &pb.SetConfigResponse{}
+	if !cephconfig.IsModuleOption(req.Name) {
+		info, err := c.configSvc.Validate(req.Name, req.Value)
+		if err != nil && !req.Force {
+			return nil, err
+		}
+		if err == nil {
+			res.RestartRequired = !info.CanUpdateAtRuntime
+		}
+	}
+	cmd := rados.MonCmd{Prefix: "config set", Format: "json", Args: map[string]any{
+		"who":   req.Who,
+		"name":  req.Name,
+		"value": req.Value,
+	}}
+	if req.Force {
+		cmd.Args["force"] = true
+	}
+	_, err := c.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *clusterAPI) RemoveConfig(ctx context.Context, req *pb.RemoveConfigRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermDelete); err != nil {
+		return nil, err
+	}
+	if req.Who == "" || req.Name == "" {
+		return nil, fmt.Errorf("%w: who and name are required", types.ErrInvalidArg)
+	}
+	_, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config rm", Format: "json", Args: map[string]any{
+		"who":  req.Who,
+		"name": req.Name,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *clusterAPI) DumpConfig(ctx context.Context, req *pb.DumpConfigRequest) (*pb.DumpConfigResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
+		return nil, err
+	}
+	cmdRes, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config dump", Format: "json"})
+	if err != nil {
+		return nil, err
+	}
+	var params []*pb.ConfigDumpEntry
+	if err := json.Unmarshal(cmdRes, &params); err != nil {
+		return nil, err
+	}
+	if req.Who == nil || *req.Who == "" {
+		return &pb.DumpConfigResponse{Params: params}, nil
+	}
+	filtered := make([]*pb.ConfigDumpEntry, 0, len(params))
+	for _, p := range params {
+		if p.Section == *req.Who {
+			filtered = append(filtered, p)
+		}
+	}
+	return &pb.DumpConfigResponse{Params: filtered}, nil
+}
+
+const defaultConfigHistoryPageSize = 20
+
+func (c *clusterAPI) GetConfigHistory(ctx context.Context, req *pb.GetConfigHistoryRequest) (*pb.GetConfigHistoryResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermRead); err != nil {
+		return nil, err
+	}
+	pageSize := uint64(defaultConfigHistoryPageSize)
+	if req.PageSize != nil {
+		if *req.PageSize <= 0 {
+			return nil, fmt.Errorf("%w: page_size must be positive", types.ErrInvalidArg)
+		}
+		pageSize = uint64(*req.PageSize)
+	}
+
+	// config log returns last num changes starting from the latest version.
+	// Versions are sequential, so page token is the version to start the page from.
+	num := pageSize
+	if req.PageToken != nil {
+		latest, err := c.configLog(ctx, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(latest) == 0 {
+			return &pb.GetConfigHistoryResponse{}, nil
+		}
+		if *req.PageToken < latest[0].Version {
+			num += latest[0].Version - *req.PageToken
+		}
+	}
+	changeSets, err := c.configLog(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetConfigHistoryResponse{}
+	for _, cs := range changeSets {
+		if req.PageToken != nil && cs.Version > *req.PageToken {
+			continue
+		}
+		if uint64(len(res.Entries)) == pageSize {
+			break
+		}
+		res.Entries = append(res.Entries, c.configChangeSetToPb(cs))
+	}
+	if len(res.Entries) != 0 {
+		if last := res.Entries[len(res.Entries)-1].Version; last > 1 {
+			res.NextPageToken = proto.Uint64(last - 1)
+		}
+	}
+	return res, nil
+}
+
+func (c *clusterAPI) RollbackConfig(ctx context.Context, req *pb.RollbackConfigRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeConfigOpt, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	_, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config reset", Format: "json", Args: map[string]any{
+		"num": req.Version,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c *clusterAPI) configLog(ctx context.Context, num uint64) ([]types.ConfigChangeSet, error) {
+	cmdRes, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config log", Format: "json", Args: map[string]any{
+		"num": num,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	var res []types.ConfigChangeSet
+	if err := json.Unmarshal(cmdRes, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *clusterAPI) configChangeSetToPb(cs types.ConfigChangeSet) *pb.ConfigChangeSet {
+	res := &pb.ConfigChangeSet{
+		Version:   cs.Version,
+		Timestamp: cs.Timestamp.Timestamp,
+		Name:      cs.Name,
+		Changes:   make([]*pb.ConfigChange, len(cs.Changes)),
+	}
+	for i, change := range cs.Changes {
+		who, name := splitConfigKey(change.Name)
+		pbChange := &pb.ConfigChange{
+			Who:           who,
+			Name:          name,
+			PreviousValue: change.PreviousValue,
+			NewValue:      change.NewValue,
+		}
+		if info, ok := c.configSvc.Get(name); ok {
+			pbChange.Desc = info.Desc
+			pbChange.Type = info.Type
+		}
+		res.Changes[i] = pbChange
+	}
+	return res
+}
+
+// splitConfigKey splits config log key into who and parameter name.
+// Who can contain mask, e.g: "osd/class:ssd/osd_max_backfills",
+// and mgr module option names contain slashes, e.g: "mgr/mgr/dashboard/ssl".
+func splitConfigKey(key string) (string, string) {
+	parts := strings.Split(key, "/")
+	if len(parts) == 1 {
+		return "", key
+	}
+	for i := 1; i < len(parts)-1; i++ {
+		if parts[i] == "mgr" {
+			return strings.Join(parts[:i], "/"), strings.Join(parts[i:], "/")
+		}
+	}
+	return strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+}
+
+// capsArg converts capabilities by entity type to "caps" argument of auth commands,
+// e.g: ["mon", "allow r", "osd", "allow rw"].
+func capsArg(caps map[string]string) []string {
+	keys := make([]string, 0, len(caps))
+	for k := range caps {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]string, 0, len(caps)*2)
+	for _, k := range keys {
+		res = append(res, k, caps[k])
+	}
+	return res
+}
@@ -0,0 +1,382 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/cephconfig"
+	"github.com/clyso/ceph-api/pkg/types"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// erasure pool type in osd dump
+const poolTypeErasure = 3
+
+// NewClusterStateAPI creates declarative API on top of other APIs.
+// Permissions are checked by underlying APIs for every resource kind present in desired state.
+func NewClusterStateAPI(clusterAPI pb.ClusterServer, poolAPI pb.PoolServer, crushRuleAPI pb.CrushRuleServer, configSvc *cephconfig.Config) pb.ClusterStateServer {
+	return &clusterStateAPI{
+		clusterAPI:   clusterAPI,
+		poolAPI:      poolAPI,
+		crushRuleAPI: crushRuleAPI,
+		configSvc:    configSvc,
+	}
+}
+
+type clusterStateAPI struct {
+	clusterAPI   pb.ClusterServer
+	poolAPI      pb.PoolServer
+	crushRuleAPI pb.CrushRuleServer
+	configSvc    *cephconfig.Config
+}
+
+// plannedChange is a plan item with function to apply it.
+type plannedChange struct {
+	item  *pb.PlanItem
+	apply func(ctx context.Context) error
+}
+
+func (c *clusterStateAPI) Plan(ctx context.Context, req *pb.DesiredState) (*pb.PlanResponse, error) {
+	changes, err := c.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.PlanResponse{Items: make([]*pb.PlanItem, len(changes))}
+	for i, change := range changes {
+		res.Items[i] = change.item
+	}
+	return res, nil
+}
+
+func (c *clusterStateAPI) Apply(ctx context.Context, req *pb.ApplyRequest) (*pb.ApplyResponse, error) {
+	if req.State == nil {
+		return nil, fmt.Errorf("%w: state is required", types.ErrInvalidArg)
+	}
+	changes, err := c.plan(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	// do not apply anything if plan contains invalid changes
+	planValid := true
+	for _, change := range changes {
+		if change.item.Error != nil {
+			planValid = false
+			break
+		}
+	}
+
+	res := &pb.ApplyResponse{Results: make([]*pb.ApplyItemResult, len(changes))}
+	failed := !planValid
+	for i, change := range changes {
+		result := &pb.ApplyItemResult{Item: change.item}
+		res.Results[i] = result
+		switch {
+		case change.item.Error != nil:
+			result.Status = pb.ApplyItemResult_failed
+			result.Error = change.item.Error
+		case change.item.Action == pb.PlanItem_no_change:
+			result.Status = pb.ApplyItemResult_unchanged
+		case failed || req.DryRun:
+			result.Status = pb.ApplyItemResult_skipped
+		default:
+			if err := change.apply(ctx); err != nil {
+				failed = true
+				result.Status = pb.ApplyItemResult_failed
+				result.Error = proto.String(err.Error())
+				continue
+			}
+			result.Status = pb.ApplyItemResult_applied
+		}
+	}
+	return res, nil
+}
+
+func (c *clusterStateAPI) plan(ctx context.Context, req *pb.DesiredState) ([]plannedChange, error) {
+	var res []plannedChange
+
+	// crush rules are needed to plan both crush rules and pools
+	var rules []*pb.Rule
+	if len(req.CrushRules) != 0 || len(req.Pools) != 0 {
+		rulesRes, err := c.crushRuleAPI.ListRules(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		rules = rulesRes.Rules
+	}
+	if len(req.CrushRules) != 0 {
+		res = append(res, c.planCrushRules(req.CrushRules, rules)...)
+	}
+	if len(req.Pools) != 0 {
+		pools, err := c.poolAPI.ListPools(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, c.planPools(req.Pools, pools.Pools, rules)...)
+	}
+	if len(req.Config) != 0 {
+		dump, err := c.clusterAPI.DumpConfig(ctx, &pb.DumpConfigRequest{})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, c.planConfig(req.Config, dump.Params)...)
+	}
+	if len(req.Users) != 0 {
+		users, err := c.clusterAPI.GetUsers(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, c.planUsers(req.Users, users.Users)...)
+	}
+	return res, nil
+}
+
+func (c *clusterStateAPI) planCrushRules(desired []*pb.CreateRuleRequest, current []*pb.Rule) []plannedChange {
+	existing := make(map[string]struct{}, len(current))
+	for _, rule := range current {
+		existing[rule.RuleName] = struct{}{}
+	}
+	res := make([]plannedChange, 0, len(desired))
+	for _, rule := range desired {
+		rule := rule
+		item := &pb.PlanItem{Kind: pb.PlanItem_crush_rule, Name: rule.Name}
+		res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+			_, err := c.crushRuleAPI.CreateRule(ctx, rule)
+			return err
+		}})
+		if rule.Name == "" {
+			item.Error = proto.String("name is required")
+			continue
+		}
+		// ceph does not support crush rule modification, existing rule is kept as is
+		if _, ok := existing[rule.Name]; ok {
+			continue
+		}
+		item.Action = pb.PlanItem_create
+		item.Diff = append(item.Diff, &pb.FieldDiff{Field: "failure_domain", Desired: rule.FailureDomain})
+		if rule.Root != nil {
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "root", Desired: *rule.Root})
+		}
+		if rule.DeviceClass != nil {
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "device_class", Desired: *rule.DeviceClass})
+		}
+		if rule.PoolType == pb.PoolType_erasure && rule.Profile != nil {
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "profile", Desired: *rule.Profile})
+		}
+	}
+	return res
+}
+
+func (c *clusterStateAPI) planPools(desired []*pb.CreatePoolRequest, current []*pb.OsdDumpPool, rules []*pb.Rule) []plannedChange {
+	pools := make(map[string]*pb.OsdDumpPool, len(current))
+	for _, pool := range current {
+		pools[pool.PoolName] = pool
+	}
+	ruleNames := make(map[int64]string, len(rules))
+	for _, rule := range rules {
+		ruleNames[rule.RuleId] = rule.RuleName
+	}
+
+	res := make([]plannedChange, 0, len(desired))
+	for _, pool := range desired {
+		pool := pool
+		item := &pb.PlanItem{Kind: pb.PlanItem_pool, Name: pool.Name}
+		if pool.Name == "" {
+			item.Error = proto.String("name is required")
+			res = append(res, plannedChange{item: item})
+			continue
+		}
+		cur, ok := pools[pool.Name]
+		if !ok {
+			item.Action = pb.PlanItem_create
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "pool_type", Desired: pool.PoolType.String()})
+			item.Diff = appendInt32Diff(item.Diff, "size", nil, pool.Size)
+			item.Diff = appendInt32Diff(item.Diff, "min_size", nil, pool.MinSize)
+			item.Diff = appendInt32Diff(item.Diff, "pg_num", nil, pool.PgNum)
+			item.Diff = appendStrDiff(item.Diff, "crush_rule", nil, pool.CrushRule)
+			item.Diff = appendStrDiff(item.Diff, "erasure_code_profile", nil, pool.ErasureCodeProfile)
+			item.Diff = appendStrDiff(item.Diff, "application", nil, pool.Application)
+			res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+				_, err := c.poolAPI.CreatePool(ctx, pool)
+				return err
+			}})
+			continue
+		}
+
+		curType := pb.PoolType_replication
+		if cur.Type == poolTypeErasure {
+			curType = pb.PoolType_erasure
+		}
+		if curType != pool.PoolType {
+			item.Error = proto.String(fmt.Sprintf("pool type cannot be changed from %s to %s", curType, pool.PoolType))
+		} else if pool.ErasureCodeProfile != nil && *pool.ErasureCodeProfile != cur.ErasureCodeProfile {
+			item.Error = proto.String(fmt.Sprintf("erasure code profile cannot be changed from %q to %q", cur.ErasureCodeProfile, *pool.ErasureCodeProfile))
+		}
+
+		update := &pb.UpdatePoolRequest{Name: pool.Name}
+		if pool.Size != nil && *pool.Size != cur.Size {
+			update.Size = pool.Size
+			item.Diff = appendInt32Diff(item.Diff, "size", &cur.Size, pool.Size)
+		}
+		if pool.MinSize != nil && *pool.MinSize != cur.MinSize {
+			update.MinSize = pool.MinSize
+			item.Diff = appendInt32Diff(item.Diff, "min_size", &cur.MinSize, pool.MinSize)
+		}
+		if pool.PgNum != nil && *pool.PgNum != cur.PgNum {
+			update.PgNum = pool.PgNum
+			item.Diff = appendInt32Diff(item.Diff, "pg_num", &cur.PgNum, pool.PgNum)
+		}
+		if pool.CrushRule != nil {
+			curRule := ruleNames[int64(cur.CrushRule)]
+			if *pool.CrushRule != curRule {
+				update.CrushRule = pool.CrushRule
+				item.Diff = appendStrDiff(item.Diff, "crush_rule", &curRule, pool.CrushRule)
+			}
+		}
+		if pool.Application != nil {
+			if _, ok := cur.GetApplicationMetadata().GetFields()[*pool.Application]; !ok {
+				update.Application = pool.Application
+				item.Diff = appendStrDiff(item.Diff, "application", nil, pool.Application)
+			}
+		}
+		if len(item.Diff) != 0 {
+			item.Action = pb.PlanItem_update
+		}
+		res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+			_, err := c.poolAPI.UpdatePool(ctx, update)
+			return err
+		}})
+	}
+	return res
+}
+
+func (c *clusterStateAPI) planConfig(desired []*pb.SetConfigRequest, current []*pb.ConfigDumpEntry) []plannedChange {
+	values := make(map[string]string, len(current))
+	for _, param := range current {
+		who := param.Section
+		if param.Mask != "" {
+			who += "/" + param.Mask
+		}
+		values[who+"/"+param.Name] = param.Value
+	}
+
+	res := make([]plannedChange, 0, len(desired))
+	for _, param := range desired {
+		param := param
+		key := param.Who + "/" + param.Name
+		item := &pb.PlanItem{Kind: pb.PlanItem_config, Name: key}
+		res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+			_, err := c.clusterAPI.SetConfig(ctx, param)
+			return err
+		}})
+		if param.Who == "" || param.Name == "" {
+			item.Error = proto.String("who and name are required")
+			continue
+		}
+		if !param.Force && !cephconfig.IsModuleOption(param.Name) {
+			if _, err := c.configSvc.Validate(param.Name, param.Value); err != nil {
+				item.Error = proto.String(err.Error())
+				continue
+			}
+		}
+		cur, ok := values[key]
+		switch {
+		case !ok:
+			item.Action = pb.PlanItem_create
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "value", Desired: param.Value})
+		case cur != param.Value:
+			item.Action = pb.PlanItem_update
+			item.Diff = append(item.Diff, &pb.FieldDiff{Field: "value", Current: proto.String(cur), Desired: param.Value})
+		}
+	}
+	return res
+}
+
+func (c *clusterStateAPI) planUsers(desired []*pb.UpdateClusterUserReq, current []*pb.ClusterUser) []plannedChange {
+	users := make(map[string]*pb.ClusterUser, len(current))
+	for _, u := range current {
+		users[u.Entity] = u
+	}
+
+	res := make([]plannedChange, 0, len(desired))
+	for _, u := range desired {
+		u := u
+		item := &pb.PlanItem{Kind: pb.PlanItem_user, Name: u.UserEntity}
+		if u.UserEntity == "" {
+			item.Error = proto.String("user_entity is required")
+			res = append(res, plannedChange{item: item})
+			continue
+		}
+		cur, ok := users[u.UserEntity]
+		if !ok {
+			item.Action = pb.PlanItem_create
+			for _, k := range sortedKeys(u.Capabilities) {
+				item.Diff = append(item.Diff, &pb.FieldDiff{Field: "caps." + k, Desired: u.Capabilities[k]})
+			}
+			res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+				_, err := c.clusterAPI.CreateUser(ctx, &pb.CreateClusterUserReq{UserEntity: u.UserEntity, Capabilities: u.Capabilities})
+				return err
+			}})
+			continue
+		}
+		// auth caps replaces all capabilities, so removed ones are shown in diff too
+		keys := sortedKeys(u.Capabilities)
+		for k := range cur.Caps {
+			if _, ok := u.Capabilities[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		for _, k := range keys {
+			curVal, curOk := cur.Caps[k]
+			desiredVal := u.Capabilities[k]
+			if curOk && curVal == desiredVal {
+				continue
+			}
+			diff := &pb.FieldDiff{Field: "caps." + k, Desired: desiredVal}
+			if curOk {
+				diff.Current = proto.String(curVal)
+			}
+			item.Diff = append(item.Diff, diff)
+		}
+		if len(item.Diff) != 0 {
+			item.Action = pb.PlanItem_update
+		}
+		res = append(res, plannedChange{item: item, apply: func(ctx context.Context) error {
+			_, err := c.clusterAPI.UpdateUser(ctx, u)
+			return err
+		}})
+	}
+	return res
+}
+
+func appendInt32Diff(diff []*pb.FieldDiff, field string, current, desired *int32) []*pb.FieldDiff {
+	if desired == nil {
+		return diff
+	}
+	res := &pb.FieldDiff{Field: field, Desired: strconv.Itoa(int(*desired))}
+	if current != nil {
+		res.Current = proto.String(strconv.Itoa(int(*current)))
+	}
+	return append(diff, res)
+}
+
+func appendStrDiff(diff []*pb.FieldDiff, field string, current, desired *string) []*pb.FieldDiff {
+	if desired == nil {
+		return diff
+	}
+	return append(diff, &pb.FieldDiff{Field: field, Current: current, Desired: *desired})
+}
+
+func sortedKeys(m map[string]string) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/cephcmd"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// commandScopes maps command prefixes to RBAC scopes. First matching prefix wins.
+// Commands without matching prefix require all permissions of manager scope.
+var commandScopes = []struct {
+	prefix string
+	// if set, command must also end with one of suffixes
+	suffixes []string
+	scope    user.Scope
+	// command exposes or changes credentials, e.g: ceph keyrings or ceph-api accessdb stored in config-key.
+	// All scope permissions are required regardless of command perm.
+	sensitive bool
+}{
+	{prefix: "auth ", scope: user.ScopeUser, sensitive: true},
+	{prefix: "config-key ", scope: user.ScopeUser, sensitive: true},
+	{prefix: "dashboard ac-", scope: user.ScopeUser, sensitive: true},
+	{prefix: "dashboard get-", suffixes: []string{"-password", "-secret-key", "-access-key", "-api-key"}, scope: user.ScopeUser, sensitive: true},
+	{prefix: "restful ", scope: user.ScopeUser, sensitive: true},
+	{prefix: "orch sd dump cert", scope: user.ScopeUser, sensitive: true},
+	{prefix: "dashboard ", scope: user.ScopeDashboardSettings},
+	{prefix: "osd pool ", scope: user.ScopePool},
+	{prefix: "osd erasure-code-profile ", scope: user.ScopePool},
+	{prefix: "config ", scope: user.ScopeConfigOpt},
+	{prefix: "osd ", scope: user.ScopeOsd},
+	{prefix: "pg ", scope: user.ScopeOsd},
+	{prefix: "mon ", scope: user.ScopeMonitor},
+	{prefix: "quorum_status", scope: user.ScopeMonitor},
+	{prefix: "status", scope: user.ScopeMonitor},
+	{prefix: "health", scope: user.ScopeMonitor},
+	{prefix: "report", scope: user.ScopeMonitor},
+	{prefix: "fs ", scope: user.ScopeCephfs},
+	{prefix: "mds ", scope: user.ScopeCephfs},
+	{prefix: "nfs ", scope: user.ScopeNfsGanesha},
+	{prefix: "rbd ", scope: user.ScopeRbdImage},
+	{prefix: "orch ", scope: user.ScopeHosts},
+	{prefix: "log ", scope: user.ScopeLog},
+}
+
+func NewCommandAPI(radosSvc *rados.Svc, index *cephcmd.Index) pb.CommandServer {
+	return &commandAPI{
+		radosSvc: radosSvc,
+		index:    index,
+	}
+}
+
+type commandAPI struct {
+	radosSvc *rados.Svc
+	index    *cephcmd.Index
+}
+
+func (c *commandAPI) ExecCommand(ctx context.Context, req *pb.ExecCommandRequest) (*pb.ExecCommandResponse, error) {
+	cmd, monCmd, err := c.index.Validate(req.Prefix, req.Args.AsMap())
+	if err != nil {
+		return nil, err
+	}
+	scope, perms := commandPermissions(cmd)
+	if err = user.HasPermissions(ctx, scope, perms...); err != nil {
+		return nil, err
+	}
+
+	var cmdRes []byte
+	if cmd.Mgr() {
+		cmdRes, err = c.radosSvc.ExecMgr(ctx, monCmd)
+	} else {
+		cmdRes, err = c.radosSvc.ExecMon(ctx, monCmd)
+	}
+	if err != nil {
+		if errors.Is(err, types.RadosErrorNotFound) {
+			return nil, fmt.Errorf("%w: %v", types.ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: ceph command %q failed: %v", types.ErrFailedPrecondition, cmd.Prefix, err)
+	}
+
+	res := &pb.ExecCommandResponse{Target: commandTarget(cmd)}
+	var out any
+	if len(cmdRes) != 0 && json.Unmarshal(cmdRes, &out) == nil {
+		res.Result, err = structpb.NewValue(out)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res.Output = string(cmdRes)
+	}
+	return res, nil
+}
+
+func (c *commandAPI) ListCommands(ctx context.Context, req *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	if err := hasAnyScopeRead(ctx); err != nil {
+		return nil, err
+	}
+	cmds := c.index.List(req.GetPrefix())
+	res := &pb.ListCommandsResponse{Commands: make([]*pb.CommandDescription, 0, len(cmds))}
+	for _, cmd := range cmds {
+		if cmd.Flags&cephcmd.FlagObsolete != 0 {
+			continue
+		}
+		desc := &pb.CommandDescription{
+			Prefix: cmd.Prefix,
+			Args:   make([]*pb.CommandArg, 0, len(cmd.Args)),
+			Help:   cmd.Help,
+			Module: cmd.Module,
+			Perm:   cmd.Perm,
+			Target: commandTarget(cmd),
+		}
+		for _, a := range cmd.Args {
+			arg := &pb.CommandArg{
+				Name:      a.Name,
+				Type:      a.Type,
+				Goodchars: a.GoodChars,
+				Range:     a.Range,
+				List:      a.List,
+				Required:  a.Required,
+			}
+			if a.Strings != "" {
+				arg.Choices = strings.Split(a.Strings, "|")
+			}
+			desc.Args = append(desc.Args, arg)
+		}
+		res.Commands = append(res.Commands, desc)
+	}
+	return res, nil
+}
+
+// commandPermissions returns RBAC scope and permissions required to execute the command.
+// Ceph capability "r" maps to read permission. Commands requiring write or execute capability
+// may create, change and remove resources, so create, update and delete permissions are required.
+// Unmapped commands may expose credentials of mgr modules, so they require all permissions.
+func commandPermissions(cmd cephcmd.Command) (user.Scope, []user.Permission) {
+	scope := user.ScopeManager
+	sensitive := true
+	for _, s := range commandScopes {
+		if !strings.HasPrefix(cmd.Prefix, s.prefix) {
+			continue
+		}
+		if len(s.suffixes) != 0 && !slices.ContainsFunc(s.suffixes, func(suffix string) bool {
+			return strings.HasSuffix(cmd.Prefix, suffix)
+		}) {
+			continue
+		}
+		scope, sensitive = s.scope, s.sensitive
+		break
+	}
+	all := []user.Permission{user.PermRead, user.PermCreate, user.PermUpdate, user.PermDelete}
+	switch {
+	case sensitive:
+		return scope, all
+	case !cmd.Write():
+		return scope, []user.Permission{user.PermRead}
+	case strings.Contains(cmd.Perm, "r"):
+		return scope, all
+	default:
+		return scope, all[1:]
+	}
+}
+
+// hasAnyScopeRead checks that caller can read at least one scope.
+// Command descriptions are not scoped, so any reader is allowed to list them.
+func hasAnyScopeRead(ctx context.Context) error {
+	for _, perms := range xctx.GetPermissions(ctx) {
+		if slices.Contains(perms, user.PermRead.String()) {
+			return nil
+		}
+	}
+	return types.ErrAccessDenied
+}
+
+func commandTarget(cmd cephcmd.Command) string {
+	if cmd.Mgr() {
+		return "mgr"
+	}
+	return "mon"
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/cephcmd"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandPermissions(t *testing.T) {
+	all := []user.Permission{user.PermRead, user.PermCreate, user.PermUpdate, user.PermDelete}
+	tests := []struct {
+		prefix    string
+		perm      string
+		wantScope user.Scope
+		wantPerms []user.Permission
+	}{
+		{prefix: "osd dump", perm: "r", wantScope: user.ScopeOsd, wantPerms: all[:1]},
+		{prefix: "osd pool create", perm: "rw", wantScope: user.ScopePool, wantPerms: all},
+		{prefix: "osd pool rm", perm: "w", wantScope: user.ScopePool, wantPerms: all[1:]},
+		{prefix: "dashboard get-grafana-api-url", perm: "r", wantScope: user.ScopeDashboardSettings, wantPerms: all[:1]},
+		{prefix: "config-key get", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "restful list-keys", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "orch sd dump cert", perm: "rw", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "dashboard get-grafana-api-password", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "dashboard get-rgw-api-secret-key", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "dashboard get-rgw-api-access-key", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "dashboard get-issue-tracker-api-key", perm: "r", wantScope: user.ScopeUser, wantPerms: all},
+		{prefix: "balancer status", perm: "r", wantScope: user.ScopeManager, wantPerms: all},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			scope, perms := commandPermissions(cephcmd.Command{Prefix: tt.prefix, Perm: tt.perm, Flags: cephcmd.FlagMgr})
+			require.Equal(t, tt.wantScope, scope)
+			require.Equal(t, tt.wantPerms, perms)
+		})
+	}
+}
+
+func TestCommandPermissions_ReadOnly(t *testing.T) {
+	r := require.New(t)
+	// read permission for every scope
+	readAll := map[string][]string{}
+	for _, s := range []user.Scope{user.ScopeUser, user.ScopeManager, user.ScopeOsd, user.ScopeDashboardSettings} {
+		readAll[string(s)] = []string{user.PermRead.String()}
+	}
+	ctx := xctx.SetPermissions(context.Background(), readAll)
+
+	scope, perms := commandPermissions(cephcmd.Command{Prefix: "osd dump", Perm: "r"})
+	r.NoError(user.HasPermissions(ctx, scope, perms...))
+	scope, perms = commandPermissions(cephcmd.Command{Prefix: "restful list-keys", Perm: "r", Flags: cephcmd.FlagMgr})
+	r.ErrorIs(user.HasPermissions(ctx, scope, perms...), types.ErrAccessDenied)
+	scope, perms = commandPermissions(cephcmd.Command{Prefix: "balancer status", Perm: "r", Flags: cephcmd.FlagMgr})
+	r.ErrorIs(user.HasPermissions(ctx, scope, perms...), types.ErrAccessDenied)
+}
+
+func TestCommandPermissions_Dashboard(t *testing.T) {
+	r := require.New(t)
+	// dashboard-settings scope permissions of administrator system role
+	ctx := xctx.SetPermissions(context.Background(), map[string][]string{
+		"dashboard-settings": {"create", "delete", "read", "update"},
+	})
+	scope, perms := commandPermissions(cephcmd.Command{Prefix: "dashboard get-grafana-api-url", Perm: "r", Flags: cephcmd.FlagMgr})
+	r.NoError(user.HasPermissions(ctx, scope, perms...))
+	scope, perms = commandPermissions(cephcmd.Command{Prefix: "dashboard set-grafana-api-url", Perm: "w", Flags: cephcmd.FlagMgr})
+	r.NoError(user.HasPermissions(ctx, scope, perms...))
+}
+
+func TestHasAnyScopeRead(t *testing.T) {
+	r := require.New(t)
+	r.ErrorIs(hasAnyScopeRead(context.Background()), types.ErrAccessDenied)
+	ctx := xctx.SetPermissions(context.Background(), map[string][]string{"pool": {"create"}})
+	r.ErrorIs(hasAnyScopeRead(ctx), types.ErrAccessDenied)
+	ctx = xctx.SetPermissions(context.Background(), map[string][]string{"pool": {"create"}, "log": {"read"}})
+	r.NoError(hasAnyScopeRead(ctx))
+}
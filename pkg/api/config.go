@@ -1,10 +1,23 @@
 package api
 
+import "time"
+
 type Config struct {
-	HttpPort       int  `yaml:"httpPort"`
-	GrpcPort       int  `yaml:"grpcPort"`
-	GrpcReflection bool `yaml:"grpcReflection"`
-	Secure         bool `yaml:"secure"`
-	ServeDebug     bool `yaml:"serveDebug"`
-	AccessLog      bool `yaml:"accessLog"`
+	HttpPort           int           `yaml:"httpPort"`
+	GrpcPort           int           `yaml:"grpcPort"`
+	GrpcReflection     bool          `yaml:"grpcReflection"`
+	Secure             bool          `yaml:"secure"`
+	ServeDebug         bool          `yaml:"serveDebug"`
+	AccessLog          bool          `yaml:"accessLog"`
+	StatusPollInterval time.Duration `yaml:"statusPollInterval"`
+	LogPollInterval    time.Duration `yaml:"logPollInterval"`
+	// TLS server certificate and key PEM files. Self-signed certificate is used if not set.
+	// Files are watched and reloaded on change.
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+	// CA bundle PEM file which issued server certificate. Used by REST gateway to verify grpc server.
+	// Gateway trusts only current server certificate if not set.
+	CAFile string `yaml:"caFile"`
+	// CA bundle PEM file to verify gRPC client certificates. Client certificates are not requested if not set.
+	ClientCAFile string `yaml:"clientCAFile"`
 }
@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -39,37 +42,30 @@ func (c *crushRuleAPI) CreateRule(ctx context.Context, req *pb.CreateRuleRequest
 	if req.FailureDomain == "" {
 		return nil, fmt.Errorf("%w: failure domain is required", types.ErrInvalidArg)
 	}
-	// Prepare the command map
-	cmdMap := map[string]interface{}{
-		"prefix": "osd crush rule create-replicated",
-		"name":   req.Name,
-		"type":   req.FailureDomain,
-		"format": "json",
-	}
+	// Prepare the command
+	cmd := rados.MonCmd{Prefix: "osd crush rule create-replicated", Format: "json", Args: map[string]any{
+		"name": req.Name,
+		"type": req.FailureDomain,
+	}}
 
 	// Add optional fields if present
 	if req.DeviceClass != nil {
-		cmdMap["class"] = *req.DeviceClass
+		cmd.Args["class"] = *req.DeviceClass
 	}
 
 	// Adjust command and fields based on PoolType
 	if req.PoolType == pb.PoolType_erasure {
-		cmdMap["prefix"] = "osd crush rule create-erasure"
+		cmd.Prefix = "osd crush rule create-erasure"
 		if req.Profile != nil {
-			cmdMap["profile"] = *req.Profile
+			cmd.Args["profile"] = *req.Profile
 		}
 	} else {
 		if req.Root != nil {
-			cmdMap["root"] = *req.Root
+			cmd.Args["root"] = *req.Root
 		}
 	}
 
-	cmdBytes, err := json.Marshal(cmdMap)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = c.radosSvc.ExecMon(ctx, string(cmdBytes))
+	_, err := c.radosSvc.ExecMon(ctx, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -81,19 +77,22 @@ func (c *crushRuleAPI) DeleteRule(ctx context.Context, req *pb.DeleteRuleRequest
 	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermDelete); err != nil {
 		return nil, err
 	}
-
-	cmdMap := map[string]interface{}{
-		"prefix": "osd crush rule rm",
-		"name":   req.Name,
-		"format": "json",
+	if version := requestVersion(ctx, req.Version); version != "" {
+		// crush rule cannot be changed in place, so version changes only if rule was re-created
+		rule, err := c.getRule(ctx, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if rule.Version != version {
+			return nil, fmt.Errorf("%w: crush rule %q was changed by someone else, current version is %q", types.ErrFailedPrecondition, req.Name, rule.Version)
+		}
 	}
 
-	cmdBytes, err := json.Marshal(cmdMap)
-	if err != nil {
-		return nil, err
-	}
+	cmd := rados.MonCmd{Prefix: "osd crush rule rm", Format: "json", Args: map[string]any{
+		"name": req.Name,
+	}}
 
-	_, err = c.radosSvc.ExecMon(ctx, string(cmdBytes))
+	_, err := c.radosSvc.ExecMon(ctx, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -105,19 +104,23 @@ func (c *crushRuleAPI) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb
 	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
 		return nil, err
 	}
-	const cmdTempl = `{"prefix": "osd crush dump", "format": "json"}`
-	res, err := c.radosSvc.ExecMon(ctx, cmdTempl)
+	rule, err := c.getRule(ctx, req.Name)
 	if err != nil {
 		return nil, err
 	}
-	var dump crushDump
-	if err := json.Unmarshal(res, &dump); err != nil {
+	setETag(ctx, rule.Version)
+	return rule, nil
+}
+
+func (c *crushRuleAPI) getRule(ctx context.Context, name string) (*pb.Rule, error) {
+	rules, err := c.dumpRules(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	// Find the rule by name.
-	for _, rule := range dump.Rules {
-		if rule.RuleName == req.Name {
+	for _, rule := range rules {
+		if rule.RuleName == name {
 			return rule, nil
 		}
 	}
@@ -125,12 +128,9 @@ func (c *crushRuleAPI) GetRule(ctx context.Context, req *pb.GetRuleRequest) (*pb
 	return nil, types.ErrNotFound
 }
 
-func (c *crushRuleAPI) ListRules(ctx context.Context, req *emptypb.Empty) (*pb.ListRulesResponse, error) {
-	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
-		return nil, err
-	}
-	const cmdTempl = `{"prefix": "osd crush dump", "format": "json"}`
-	res, err := c.radosSvc.ExecMon(ctx, cmdTempl)
+// dumpRules returns crush rules with versions.
+func (c *crushRuleAPI) dumpRules(ctx context.Context) ([]*pb.Rule, error) {
+	res, err := c.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "osd crush dump", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +138,33 @@ func (c *crushRuleAPI) ListRules(ctx context.Context, req *emptypb.Empty) (*pb.L
 	if err := json.Unmarshal(res, &dump); err != nil {
 		return nil, err
 	}
+	for _, rule := range dump.Rules {
+		rule.Version, err = ruleVersion(rule)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dump.Rules, nil
+}
+
+// ruleVersion returns checksum of rule content.
+func ruleVersion(rule *pb.Rule) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(rule)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func (c *crushRuleAPI) ListRules(ctx context.Context, req *emptypb.Empty) (*pb.ListRulesResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermRead); err != nil {
+		return nil, err
+	}
+	rules, err := c.dumpRules(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	return &pb.ListRulesResponse{Rules: dump.Rules}, nil
+	return &pb.ListRulesResponse{Rules: rules}, nil
 }
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	ecProfileK                  = "k"
+	ecProfileM                  = "m"
+	ecProfilePlugin             = "plugin"
+	ecProfileTechnique          = "technique"
+	ecProfileCrushFailureDomain = "crush-failure-domain"
+	ecProfileCrushRoot          = "crush-root"
+	ecProfileCrushDeviceClass   = "crush-device-class"
+
+	ecDefaultPlugin = "jerasure"
+)
+
+var ecPlugins = map[string]struct{}{
+	"jerasure": {},
+	"isa":      {},
+	"lrc":      {},
+	"shec":     {},
+	"clay":     {},
+}
+
+func NewErasureCodeProfileAPI(radosSvc *rados.Svc) pb.ErasureCodeProfileServer {
+	return &erasureCodeProfileAPI{
+		radosSvc: radosSvc,
+	}
+}
+
+type erasureCodeProfileAPI struct {
+	radosSvc *rados.Svc
+}
+
+func (e *erasureCodeProfileAPI) ListProfiles(ctx context.Context, _ *emptypb.Empty) (*pb.ListProfilesResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopePool, user.PermRead); err != nil {
+		return nil, err
+	}
+	res, err := e.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "osd erasure-code-profile ls", Format: "json"})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if err := json.Unmarshal(res, &names); err != nil {
+		return nil, err
+	}
+	profiles := make([]*pb.ErasureProfile, 0, len(names))
+	for _, name := range names {
+		profile, err := e.getProfile(ctx, name)
+		if err != nil {
+			if errors.Is(err, types.ErrNotFound) {
+				// removed between ls and get
+				continue
+			}
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return &pb.ListProfilesResponse{Profiles: profiles}, nil
+}
+
+func (e *erasureCodeProfileAPI) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.ErasureProfile, error) {
+	if err := user.HasPermissions(ctx, user.ScopePool, user.PermRead); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	return e.getProfile(ctx, req.Name)
+}
+
+func (e *erasureCodeProfileAPI) CreateProfile(ctx context.Context, req *pb.CreateProfileRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopePool, user.PermCreate); err != nil {
+		return nil, err
+	}
+	profile, err := e.validateProfile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cmd := rados.MonCmd{Prefix: "osd erasure-code-profile set", Format: "json", Args: map[string]any{
+		"name":    req.Name,
+		"profile": profile,
+	}}
+	if req.Force {
+		cmd.Args["force"] = true
+	}
+	_, err = e.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (e *erasureCodeProfileAPI) DeleteProfile(ctx context.Context, req *pb.DeleteProfileRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopePool, user.PermDelete); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	cmd := rados.MonCmd{Prefix: "osd erasure-code-profile rm", Format: "json", Args: map[string]any{
+		"name": req.Name,
+	}}
+	_, err := e.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (e *erasureCodeProfileAPI) getProfile(ctx context.Context, name string) (*pb.ErasureProfile, error) {
+	cmd := rados.MonCmd{Prefix: "osd erasure-code-profile get", Format: "json", Args: map[string]any{
+		"name": name,
+	}}
+	res, err := e.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		if errors.Is(err, types.RadosErrorNotFound) {
+			return nil, fmt.Errorf("%w: erasure code profile %q not found", types.ErrNotFound, name)
+		}
+		return nil, err
+	}
+	var params map[string]string
+	if err := json.Unmarshal(res, &params); err != nil {
+		return nil, err
+	}
+	return ecProfileToPb(name, params), nil
+}
+
+// validateProfile checks create request and returns profile in "key=value" form expected by mon command.
+func (e *erasureCodeProfileAPI) validateProfile(ctx context.Context, req *pb.CreateProfileRequest) ([]string, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	if req.K < 2 {
+		return nil, fmt.Errorf("%w: k must be at least 2", types.ErrInvalidArg)
+	}
+	if req.M < 1 {
+		return nil, fmt.Errorf("%w: m must be at least 1", types.ErrInvalidArg)
+	}
+	plugin := ecDefaultPlugin
+	if req.Plugin != nil {
+		plugin = *req.Plugin
+	}
+	if _, ok := ecPlugins[plugin]; !ok {
+		return nil, fmt.Errorf("%w: unknown erasure code plugin %q", types.ErrInvalidArg, plugin)
+	}
+
+	params := map[string]string{}
+	for k, v := range req.Options {
+		switch k {
+		case ecProfileK, ecProfileM, ecProfilePlugin, ecProfileTechnique, ecProfileCrushFailureDomain, ecProfileCrushRoot, ecProfileCrushDeviceClass:
+			return nil, fmt.Errorf("%w: profile parameter %q must be set with corresponding request field", types.ErrInvalidArg, k)
+		}
+		params[k] = v
+	}
+	params[ecProfileK] = strconv.Itoa(int(req.K))
+	params[ecProfileM] = strconv.Itoa(int(req.M))
+	params[ecProfilePlugin] = plugin
+	if req.Technique != nil {
+		params[ecProfileTechnique] = *req.Technique
+	}
+	if req.CrushRoot != nil {
+		params[ecProfileCrushRoot] = *req.CrushRoot
+	}
+	if req.CrushDeviceClass != nil {
+		params[ecProfileCrushDeviceClass] = *req.CrushDeviceClass
+	}
+	if req.CrushFailureDomain != nil {
+		if err := e.validateFailureDomain(ctx, *req.CrushFailureDomain); err != nil {
+			return nil, err
+		}
+		params[ecProfileCrushFailureDomain] = *req.CrushFailureDomain
+	}
+
+	switch plugin {
+	case "lrc":
+		l, err := strconv.Atoi(params["l"])
+		if err != nil || l <= 0 {
+			return nil, fmt.Errorf("%w: lrc plugin requires positive integer option \"l\"", types.ErrInvalidArg)
+		}
+		if (req.K+req.M)%int32(l) != 0 {
+			return nil, fmt.Errorf("%w: lrc plugin requires k+m to be a multiple of l", types.ErrInvalidArg)
+		}
+	case "shec":
+		if c, ok := params["c"]; ok {
+			cVal, err := strconv.Atoi(c)
+			if err != nil || cVal <= 0 || int32(cVal) > req.M {
+				return nil, fmt.Errorf("%w: shec plugin option \"c\" must be an integer between 1 and m", types.ErrInvalidArg)
+			}
+		}
+	}
+
+	profile := make([]string, 0, len(params))
+	for k, v := range params {
+		profile = append(profile, k+"="+v)
+	}
+	sort.Strings(profile)
+	return profile, nil
+}
+
+// validateFailureDomain checks that given failure domain is one of crush map bucket types.
+func (e *erasureCodeProfileAPI) validateFailureDomain(ctx context.Context, failureDomain string) error {
+	res, err := e.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "osd crush dump", Format: "json"})
+	if err != nil {
+		return err
+	}
+	var dump struct {
+		Types []struct {
+			Name string `json:"name"`
+		} `json:"types"`
+	}
+	if err := json.Unmarshal(res, &dump); err != nil {
+		return err
+	}
+	for _, t := range dump.Types {
+		if t.Name == failureDomain {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: crush failure domain %q is not a crush bucket type", types.ErrInvalidArg, failureDomain)
+}
+
+func ecProfileToPb(name string, params map[string]string) *pb.ErasureProfile {
+	res := &pb.ErasureProfile{
+		Name:    name,
+		Options: map[string]string{},
+	}
+	for k, v := range params {
+		switch k {
+		case ecProfileK:
+			i, _ := strconv.Atoi(v)
+			res.K = int32(i)
+		case ecProfileM:
+			i, _ := strconv.Atoi(v)
+			res.M = int32(i)
+		case ecProfilePlugin:
+			res.Plugin = v
+		case ecProfileTechnique:
+			res.Technique = v
+		case ecProfileCrushFailureDomain:
+			res.CrushFailureDomain = v
+		case ecProfileCrushRoot:
+			res.CrushRoot = v
+		case ecProfileCrushDeviceClass:
+			res.CrushDeviceClass = v
+		default:
+			res.Options[k] = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	etagHeader = "etag"
+	// If-Match request header forwarded by REST gateway
+	ifMatchMetadata = runtime.MetadataPrefix + "if-match"
+)
+
+// setETag sends resource version as ETag header of REST response.
+func setETag(ctx context.Context, version string) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagHeader, strconv.Quote(version))); err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to set etag header")
+	}
+}
+
+// requestVersion returns expected resource version from request field or from If-Match header of REST request.
+// Returns empty string if version is not set.
+func requestVersion(ctx context.Context, version *string) string {
+	if version != nil && *version != "" {
+		return *version
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get(ifMatchMetadata)
+	if len(vals) == 0 {
+		return ""
+	}
+	etag := strings.TrimPrefix(strings.TrimSpace(vals[0]), "W/")
+	if etag == "*" {
+		return ""
+	}
+	if unquoted, err := strconv.Unquote(etag); err == nil {
+		return unquoted
+	}
+	return etag
+}
+
+// outgoingHeaderMatcher passes ETag header to REST response as is and other grpc headers with default prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == etagHeader {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -2,7 +2,6 @@ package api
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -16,12 +15,12 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFunc, oauthHandlers map[string]http.HandlerFunc) (http.Handler, error) {
-	mux := runtime.NewServeMux()
+func GRPCGateway(ctx context.Context, conf Config, certs *TLSCerts, metricsHandler http.HandlerFunc, oauthHandlers, oidcHandlers map[string]http.HandlerFunc) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher))
 	var opts []grpc.DialOption
 
 	if conf.Secure {
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: false}))) //nolint: gosec
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(certs.GatewayConfig())))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
@@ -48,6 +47,38 @@ func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFu
 	if err != nil {
 		return nil, err
 	}
+	err = pb.RegisterPoolHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterErasureCodeProfileHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterOsdHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterLogsHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterClusterStateHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterAuditHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterOperationsHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = pb.RegisterCommandHandlerFromEndpoint(ctx, mux, serverAddress, opts)
+	if err != nil {
+		return nil, err
+	}
 
 	// Register metrics handler
 	if metricsHandler != nil {
@@ -57,6 +88,10 @@ func GRPCGateway(ctx context.Context, conf Config, metricsHandler http.HandlerFu
 	for path, h := range oauthHandlers {
 		handlePOST(mux, path, h)
 	}
+	// Register OpenID discovery and JWKS endpoints
+	for path, h := range oidcHandlers {
+		handleGET(mux, path, h)
+	}
 
 	if conf.ServeDebug {
 		handleGET(mux, "/debug/pprof/", pprof.Index)
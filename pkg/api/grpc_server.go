@@ -3,14 +3,17 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"runtime/debug"
 	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/audit"
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/log"
 	"github.com/clyso/ceph-api/pkg/trace"
 	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/golang/protobuf/proto"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -33,6 +36,15 @@ func NewGrpcServer(conf Config,
 	authAPI pb.AuthServer,
 	crushRuleAPI pb.CrushRuleServer,
 	statusAPI pb.StatusServer,
+	poolAPI pb.PoolServer,
+	erasureCodeProfileAPI pb.ErasureCodeProfileServer,
+	osdAPI pb.OsdServer,
+	logsAPI pb.LogsServer,
+	clusterStateAPI pb.ClusterStateServer,
+	auditAPI pb.AuditServer,
+	auditLog *audit.Log,
+	operationsAPI pb.OperationsServer,
+	commandAPI pb.CommandServer,
 	authN grpc_auth.AuthFunc,
 	tracer otel_trace.TracerProvider,
 	logConf log.Config) *grpc.Server {
@@ -41,7 +53,7 @@ func NewGrpcServer(conf Config,
 	// 	return nil, nil, err
 	// }
 
-	srv := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tracer))),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Time:    50 * time.Second,
@@ -57,6 +69,7 @@ func NewGrpcServer(conf Config,
 			grpc_auth.UnaryServerInterceptor(authN),
 			log.UnaryInterceptor(logConf),
 			trace.UnaryInterceptor(),
+			audit.UnaryInterceptor(auditLog),
 			ErrorInterceptor(),
 			unaryServerAccessLog(conf.AccessLog),
 			unaryServerRecover,
@@ -70,7 +83,13 @@ func NewGrpcServer(conf Config,
 			ErrorStreamInterceptor(),
 			streamServerAccessLog(conf.AccessLog),
 			streamServerRecover,
-		)))
+		)),
+	}
+	if conf.Secure {
+		// TLS is terminated by listener, see Serve()
+		opts = append(opts, grpc.Creds(tlsListenerCreds{}))
+	}
+	srv := grpc.NewServer(opts...)
 
 	// Register Servers
 	pb.RegisterClusterServer(srv, clusterAPI)
@@ -78,6 +97,14 @@ func NewGrpcServer(conf Config,
 	pb.RegisterAuthServer(srv, authAPI)
 	pb.RegisterCrushRuleServer(srv, crushRuleAPI)
 	pb.RegisterStatusServer(srv, statusAPI)
+	pb.RegisterPoolServer(srv, poolAPI)
+	pb.RegisterErasureCodeProfileServer(srv, erasureCodeProfileAPI)
+	pb.RegisterOsdServer(srv, osdAPI)
+	pb.RegisterLogsServer(srv, logsAPI)
+	pb.RegisterClusterStateServer(srv, clusterStateAPI)
+	pb.RegisterAuditServer(srv, auditAPI)
+	pb.RegisterOperationsServer(srv, operationsAPI)
+	pb.RegisterCommandServer(srv, commandAPI)
 	if conf.GrpcReflection {
 		reflection.Register(srv)
 	}
@@ -141,7 +168,7 @@ func rpcLogHandler(l *zerolog.Logger, err error, fullMethod string) {
 		l.Debug().Str("grpc_code", code.String()).Str("grpc_method", fullMethod).Str("response_status", "success").Msg(msg)
 	case codes.Unauthenticated:
 		l.Debug().Str("grpc_code", code.String()).Str("grpc_method", fullMethod).Str("response_status", "failed").Msg(msg)
-	case codes.PermissionDenied, codes.FailedPrecondition, codes.AlreadyExists, codes.InvalidArgument:
+	case codes.PermissionDenied, codes.FailedPrecondition, codes.AlreadyExists, codes.InvalidArgument, codes.Aborted:
 		// log expected errors with WARN level
 		l.Warn().Str("grpc_code", code.String()).Str("grpc_method", fullMethod).Str("response_status", "failed").Msg(msg)
 	default:
@@ -207,9 +234,25 @@ func convertApiError(ctx context.Context, err error) error {
 	case errors.Is(err, types.ErrUnauthenticated):
 		code = codes.Unauthenticated
 		mappedErr = types.ErrUnauthenticated
+		if errors.Is(err, user.ErrMFARequired) {
+			// client has to know that login should be retried with second factor
+			mappedErr = fmt.Errorf("%w: %w", types.ErrUnauthenticated, user.ErrMFARequired)
+		}
 	case errors.Is(err, types.ErrAccessDenied):
 		code = codes.PermissionDenied
 		mappedErr = types.ErrAccessDenied
+	case errors.Is(err, types.ErrFailedPrecondition):
+		code = codes.FailedPrecondition
+		mappedErr = types.ErrFailedPrecondition
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: err.Error(),
+		})
+	case errors.Is(err, types.ErrConflict):
+		code = codes.Aborted
+		mappedErr = types.ErrConflict
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: err.Error(),
+		})
 	default:
 		code = codes.Internal
 		mappedErr = types.ErrInternal
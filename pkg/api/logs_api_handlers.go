@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+)
+
+const (
+	defaultLogChannel      = "cluster"
+	defaultLogLevel        = "info"
+	defaultLogNum          = 10
+	defaultLogPollInterval = 2 * time.Second
+	// number of entries fetched on every tail poll.
+	// Entries above this number logged between two polls are skipped.
+	logTailBatch = 100
+)
+
+var (
+	logChannels = map[string]struct{}{"*": {}, "cluster": {}, "audit": {}, "cephadm": {}}
+	logLevels   = map[string]struct{}{"debug": {}, "info": {}, "sec": {}, "warn": {}, "error": {}}
+)
+
+func NewLogsAPI(radosSvc *rados.Svc, pollInterval time.Duration) pb.LogsServer {
+	if pollInterval <= 0 {
+		pollInterval = defaultLogPollInterval
+	}
+	return &logsAPI{
+		radosSvc:     radosSvc,
+		pollInterval: pollInterval,
+	}
+}
+
+type logsAPI struct {
+	radosSvc     *rados.Svc
+	pollInterval time.Duration
+}
+
+func (l *logsAPI) GetLast(ctx context.Context, req *pb.GetLastLogRequest) (*pb.GetLastLogResponse, error) {
+	if err := user.HasPermissions(ctx, user.ScopeLog, user.PermRead); err != nil {
+		return nil, err
+	}
+	channel, level, err := logFilter(req.Channel, req.Level)
+	if err != nil {
+		return nil, err
+	}
+	num := int32(defaultLogNum)
+	if req.Num != nil {
+		num = *req.Num
+	}
+	if num <= 0 {
+		return nil, fmt.Errorf("%w: num must be positive", types.ErrInvalidArg)
+	}
+	entries, err := l.logLast(ctx, channel, level, num)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.LogEntry, len(entries))
+	for i, e := range entries {
+		res[i] = logEntryToPb(e)
+	}
+	return &pb.GetLastLogResponse{Entries: res}, nil
+}
+
+func (l *logsAPI) Tail(req *pb.TailLogRequest, stream pb.Logs_TailServer) error {
+	ctx := stream.Context()
+	if err := user.HasPermissions(ctx, user.ScopeLog, user.PermRead); err != nil {
+		return err
+	}
+	channel, level, err := logFilter(req.Channel, req.Level)
+	if err != nil {
+		return err
+	}
+	if req.Num < 0 || req.Num > logTailBatch {
+		return fmt.Errorf("%w: num must be in range [0, %d]", types.ErrInvalidArg, logTailBatch)
+	}
+
+	entries, err := l.logLast(ctx, channel, level, logTailBatch)
+	if err != nil {
+		return err
+	}
+	// send only requested number of existing entries
+	for i := max(0, len(entries)-int(req.Num)); i < len(entries); i++ {
+		if err := stream.Send(logEntryToPb(entries[i])); err != nil {
+			return err
+		}
+	}
+	seen := logEntryKeys(entries)
+
+	ticker := time.NewTicker(l.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		entries, err = l.logLast(ctx, channel, level, logTailBatch)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if _, ok := seen[logEntryKey(e)]; ok {
+				continue
+			}
+			if err := stream.Send(logEntryToPb(e)); err != nil {
+				return err
+			}
+		}
+		// log last returns sliding window of entries, so it is enough to remember only the last batch
+		seen = logEntryKeys(entries)
+	}
+}
+
+func (l *logsAPI) logLast(ctx context.Context, channel, level string, num int32) ([]types.LogEntry, error) {
+	cmd := rados.MonCmd{Prefix: "log last", Format: "json", Args: map[string]any{
+		"num":     num,
+		"level":   level,
+		"channel": channel,
+	}}
+	res, err := l.radosSvc.ExecMon(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	var entries []types.LogEntry
+	if err := json.Unmarshal(res, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func logFilter(channel, level *string) (string, string, error) {
+	resChannel, resLevel := defaultLogChannel, defaultLogLevel
+	if channel != nil {
+		resChannel = *channel
+	}
+	if level != nil {
+		resLevel = *level
+	}
+	if _, ok := logChannels[resChannel]; !ok {
+		return "", "", fmt.Errorf("%w: unknown log channel %q", types.ErrInvalidArg, resChannel)
+	}
+	if _, ok := logLevels[resLevel]; !ok {
+		return "", "", fmt.Errorf("%w: unknown log level %q", types.ErrInvalidArg, resLevel)
+	}
+	return resChannel, resLevel, nil
+}
+
+func logEntryKey(e types.LogEntry) string {
+	return fmt.Sprintf("%s/%d/%d", e.Name, e.Seq, e.Stamp.AsTime().UnixNano())
+}
+
+func logEntryKeys(entries []types.LogEntry) map[string]struct{} {
+	res := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		res[logEntryKey(e)] = struct{}{}
+	}
+	return res
+}
+
+func logEntryToPb(e types.LogEntry) *pb.LogEntry {
+	return &pb.LogEntry{
+		Name:     e.Name,
+		Rank:     e.Rank,
+		Stamp:    e.Stamp.Timestamp,
+		Seq:      e.Seq,
+		Channel:  e.Channel,
+		Priority: e.Priority,
+		Message:  e.Message,
+	}
+}
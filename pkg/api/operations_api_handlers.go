@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/operation"
+	"github.com/clyso/ceph-api/pkg/user"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewOperationsAPI(opSvc *operation.Service) pb.OperationsServer {
+	return &operationsAPI{
+		opSvc: opSvc,
+	}
+}
+
+type operationsAPI struct {
+	opSvc *operation.Service
+}
+
+func (o *operationsAPI) ListOperations(ctx context.Context, req *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	ops, err := o.opSvc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListOperationsResponse{Operations: []*pb.Operation{}}
+	for _, op := range ops {
+		if req.Type != nil && op.Type != *req.Type {
+			continue
+		}
+		if req.Done != nil && op.Done != *req.Done {
+			continue
+		}
+		if checkOperationPermissions(ctx, op, user.PermRead) != nil {
+			continue
+		}
+		res.Operations = append(res.Operations, operationToPb(op))
+	}
+	return res, nil
+}
+
+func (o *operationsAPI) GetOperation(ctx context.Context, req *pb.GetOperationRequest) (*pb.Operation, error) {
+	op, err := o.opSvc.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOperationPermissions(ctx, op, user.PermRead); err != nil {
+		return nil, err
+	}
+	return operationToPb(op), nil
+}
+
+func (o *operationsAPI) DeleteOperation(ctx context.Context, req *pb.GetOperationRequest) (*emptypb.Empty, error) {
+	op, err := o.opSvc.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOperationPermissions(ctx, op, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if err = o.opSvc.Delete(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *operationsAPI) CancelOperation(ctx context.Context, req *pb.GetOperationRequest) (*emptypb.Empty, error) {
+	op, err := o.opSvc.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOperationPermissions(ctx, op, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if err = o.opSvc.Cancel(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *operationsAPI) WaitOperation(ctx context.Context, req *pb.WaitOperationRequest) (*pb.Operation, error) {
+	op, err := o.opSvc.Get(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOperationPermissions(ctx, op, user.PermRead); err != nil {
+		return nil, err
+	}
+	op, err = o.opSvc.Wait(ctx, req.Name, req.Timeout.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+	return operationToPb(op), nil
+}
+
+// checkOperationPermissions checks permissions for resource affected by operation.
+func checkOperationPermissions(ctx context.Context, op operation.Operation, perms ...user.Permission) error {
+	return user.HasResourcePermissions(ctx, user.Scope(op.Scope), op.Target, perms...)
+}
+
+func operationToPb(op operation.Operation) *pb.Operation {
+	res := &pb.Operation{
+		Name: op.ID,
+		Metadata: &pb.OperationMetadata{
+			Type:            op.Type,
+			Target:          op.Target,
+			Username:        op.Username,
+			CreateTime:      timestamppb.New(op.CreateTime),
+			CancelRequested: op.CancelRequested,
+		},
+		Done: op.Done,
+	}
+	if op.EndTime != nil {
+		res.Metadata.EndTime = timestamppb.New(*op.EndTime)
+	}
+	switch {
+	case op.Error != nil:
+		res.Result = &pb.Operation_Error{Error: &pb.OperationError{Code: int32(op.Error.Code), Message: op.Error.Message}}
+	case op.Done:
+		// all operations have empty response
+		resp, _ := anypb.New(&emptypb.Empty{})
+		res.Result = &pb.Operation_Response{Response: resp}
+	}
+	return res
+}
+
+// registerOperation registers operation handler. Handler errors are converted to grpc status,
+// so failed operation has the same error code and message as unary API call.
+func registerOperation(opSvc *operation.Service, typ string, h operation.Handler) {
+	opSvc.RegisterHandler(typ, func(ctx context.Context, params json.RawMessage) error {
+		return convertApiError(ctx, h(ctx, params))
+	})
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/operation"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/rs/zerolog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	opOsdDestroy = "osd.destroy"
+	opOsdPurge   = "osd.purge"
+)
+
+func NewOsdAPI(radosSvc *rados.Svc, opSvc *operation.Service) pb.OsdServer {
+	res := &osdAPI{
+		radosSvc: radosSvc,
+		opSvc:    opSvc,
+	}
+	registerOperation(opSvc, opOsdDestroy, res.removeOsdHandler("osd destroy"))
+	registerOperation(opSvc, opOsdPurge, res.removeOsdHandler("osd purge"))
+	return res
+}
+
+type osdAPI struct {
+	radosSvc *rados.Svc
+	opSvc    *operation.Service
+}
+
+func (o *osdAPI) MarkIn(ctx context.Context, req *pb.OsdIdsRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, fmt.Errorf("%w: ids are required", types.ErrInvalidArg)
+	}
+	err := o.execMon(ctx, rados.MonCmd{Prefix: "osd in", Format: "json", Args: map[string]any{
+		"ids": osdIDsToStrings(req.Ids),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *osdAPI) MarkOut(ctx context.Context, req *pb.OsdIdsRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, fmt.Errorf("%w: ids are required", types.ErrInvalidArg)
+	}
+	if err := o.checkOkToStop(ctx, req.Ids, req.Force); err != nil {
+		return nil, err
+	}
+	err := o.execMon(ctx, rados.MonCmd{Prefix: "osd out", Format: "json", Args: map[string]any{
+		"ids": osdIDsToStrings(req.Ids),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *osdAPI) MarkDown(ctx context.Context, req *pb.OsdIdsRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, fmt.Errorf("%w: ids are required", types.ErrInvalidArg)
+	}
+	if err := o.checkOkToStop(ctx, req.Ids, req.Force); err != nil {
+		return nil, err
+	}
+	err := o.execMon(ctx, rados.MonCmd{Prefix: "osd down", Format: "json", Args: map[string]any{
+		"ids": osdIDsToStrings(req.Ids),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *osdAPI) Reweight(ctx context.Context, req *pb.ReweightRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if req.Id < 0 {
+		return nil, fmt.Errorf("%w: invalid osd id %d", types.ErrInvalidArg, req.Id)
+	}
+	if req.Weight < 0 || req.Weight > 1 {
+		return nil, fmt.Errorf("%w: weight must be in range [0.0, 1.0]", types.ErrInvalidArg)
+	}
+	err := o.execMon(ctx, rados.MonCmd{Prefix: "osd reweight", Format: "json", Args: map[string]any{
+		"id":     req.Id,
+		"weight": req.Weight,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *osdAPI) SetDeviceClass(ctx context.Context, req *pb.SetDeviceClassRequest) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if len(req.Ids) == 0 {
+		return nil, fmt.Errorf("%w: ids are required", types.ErrInvalidArg)
+	}
+	if req.DeviceClass == "" {
+		return nil, fmt.Errorf("%w: device class is required", types.ErrInvalidArg)
+	}
+	ids := osdIDsToStrings(req.Ids)
+	// existing class has to be removed first, otherwise set-device-class fails
+	err := o.execMon(ctx, rados.MonCmd{Prefix: "osd crush rm-device-class", Format: "json", Args: map[string]any{
+		"ids": ids,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	err = o.execMon(ctx, rados.MonCmd{Prefix: "osd crush set-device-class", Format: "json", Args: map[string]any{
+		"class": req.DeviceClass,
+		"ids":   ids,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (o *osdAPI) Destroy(ctx context.Context, req *pb.OsdIdRequest) (*pb.Operation, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermDelete); err != nil {
+		return nil, err
+	}
+	return o.removeOsd(ctx, opOsdDestroy, req)
+}
+
+func (o *osdAPI) Purge(ctx context.Context, req *pb.OsdIdRequest) (*pb.Operation, error) {
+	if err := user.HasPermissions(ctx, user.ScopeOsd, user.PermDelete); err != nil {
+		return nil, err
+	}
+	return o.removeOsd(ctx, opOsdPurge, req)
+}
+
+type osdRemoveParams struct {
+	ID    int32 `json:"id"`
+	Force bool  `json:"force"`
+}
+
+// removeOsd checks that osd is safe to destroy and starts operation removing it.
+func (o *osdAPI) removeOsd(ctx context.Context, opType string, req *pb.OsdIdRequest) (*pb.Operation, error) {
+	if req.Id < 0 {
+		return nil, fmt.Errorf("%w: invalid osd id %d", types.ErrInvalidArg, req.Id)
+	}
+	if err := o.checkSafeToDestroy(ctx, req.Id, req.Force); err != nil {
+		return nil, err
+	}
+	target := "osd." + strconv.Itoa(int(req.Id))
+	op, err := o.opSvc.Start(ctx, opType, string(user.ScopeOsd), target, osdRemoveParams{ID: req.Id, Force: req.Force})
+	if err != nil {
+		return nil, err
+	}
+	return operationToPb(op), nil
+}
+
+// removeOsdHandler returns osd.destroy or osd.purge operation handler executing given command.
+func (o *osdAPI) removeOsdHandler(prefix string) operation.Handler {
+	return func(ctx context.Context, params json.RawMessage) error {
+		var req osdRemoveParams
+		if err := json.Unmarshal(params, &req); err != nil {
+			return err
+		}
+		cmd := rados.MonCmd{Prefix: prefix, Format: "json", Args: map[string]any{
+			"id":                   req.ID,
+			"yes_i_really_mean_it": true,
+		}}
+		if req.Force {
+			cmd.Args["force"] = true
+		}
+		_, err := o.radosSvc.ExecMgr(ctx, cmd)
+		return err
+	}
+}
+
+// checkOkToStop verifies that stopping given OSDs does not reduce data availability.
+func (o *osdAPI) checkOkToStop(ctx context.Context, ids []int32, force bool) error {
+	if force {
+		logForcedOsdOp(ctx, "ok-to-stop", ids)
+		return nil
+	}
+	cmd := rados.MonCmd{Prefix: "osd ok-to-stop", Format: "json", Args: map[string]any{
+		"ids": osdIDsToStrings(ids),
+	}}
+	res, err := o.radosSvc.ExecMgr(ctx, cmd)
+	if err != nil {
+		// mgr returns EBUSY or EAGAIN if osds are not ok to stop
+		return fmt.Errorf("%w: osd(s) %v are not ok to stop: %v", types.ErrFailedPrecondition, ids, err)
+	}
+	var check struct {
+		OkToStop bool `json:"ok_to_stop"`
+	}
+	if err := json.Unmarshal(res, &check); err != nil {
+		return err
+	}
+	if !check.OkToStop {
+		return fmt.Errorf("%w: osd(s) %v are not ok to stop", types.ErrFailedPrecondition, ids)
+	}
+	return nil
+}
+
+// checkSafeToDestroy verifies that destroying given OSD does not reduce data durability.
+func (o *osdAPI) checkSafeToDestroy(ctx context.Context, id int32, force bool) error {
+	if force {
+		logForcedOsdOp(ctx, "safe-to-destroy", []int32{id})
+		return nil
+	}
+	cmd := rados.MonCmd{Prefix: "osd safe-to-destroy", Format: "json", Args: map[string]any{
+		"ids": osdIDsToStrings([]int32{id}),
+	}}
+	res, err := o.radosSvc.ExecMgr(ctx, cmd)
+	if err != nil {
+		// mgr returns EBUSY or EAGAIN if osd is not safe to destroy
+		return fmt.Errorf("%w: osd.%d is not safe to destroy: %v", types.ErrFailedPrecondition, id, err)
+	}
+	var check struct {
+		SafeToDestroy []int32 `json:"safe_to_destroy"`
+	}
+	if err := json.Unmarshal(res, &check); err != nil {
+		return err
+	}
+	for _, safeID := range check.SafeToDestroy {
+		if safeID == id {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: osd.%d is not safe to destroy", types.ErrFailedPrecondition, id)
+}
+
+func logForcedOsdOp(ctx context.Context, check string, ids []int32) {
+	zerolog.Ctx(ctx).Warn().
+		Str("username", xctx.GetUsername(ctx)).
+		Str("skipped_check", check).
+		Interface("osd_ids", ids).
+		Msg("osd safety check skipped with force flag")
+}
+
+func (o *osdAPI) execMon(ctx context.Context, cmd rados.MonCmd) error {
+	_, err := o.radosSvc.ExecMon(ctx, cmd)
+	return err
+}
+
+func osdIDsToStrings(ids []int32) []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = strconv.Itoa(int(id))
+	}
+	return res
+}
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/operation"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const opPoolDelete = "pool.delete"
+
+func NewPoolAPI(radosSvc *rados.Svc, opSvc *operation.Service) pb.PoolServer {
+	res := &poolAPI{
+		radosSvc: radosSvc,
+		opSvc:    opSvc,
+	}
+	registerOperation(opSvc, opPoolDelete, res.deletePool)
+	return res
+}
+
+type poolAPI struct {
+	radosSvc *rados.Svc
+	opSvc    *operation.Service
+}
+
+func (p *poolAPI) ListPools(ctx context.Context, _ *emptypb.Empty) (*pb.ListPoolsResponse, error) {
+	if err := user.HasAnyResourcePermissions(ctx, user.ScopePool, user.PermRead); err != nil {
+		return nil, err
+	}
+	pools, err := p.getPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.OsdDumpPool, 0, len(pools))
+	for _, pool := range pools {
+		// return only pools visible to the caller
+		if user.HasResourcePermissions(ctx, user.ScopePool, pool.PoolName, user.PermRead) != nil {
+			continue
+		}
+		res = append(res, convertToPbOsdDumpPool(pool))
+	}
+	return &pb.ListPoolsResponse{Pools: res}, nil
+}
+
+func (p *poolAPI) GetPool(ctx context.Context, req *pb.GetPoolRequest) (*pb.OsdDumpPool, error) {
+	if err := user.HasResourcePermissions(ctx, user.ScopePool, req.Name, user.PermRead); err != nil {
+		return nil, err
+	}
+	pools, err := p.getPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if pool.PoolName == req.Name {
+			return convertToPbOsdDumpPool(pool), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: pool %q not found", types.ErrNotFound, req.Name)
+}
+
+func (p *poolAPI) CreatePool(ctx context.Context, req *pb.CreatePoolRequest) (*emptypb.Empty, error) {
+	if err := user.HasResourcePermissions(ctx, user.ScopePool, req.Name, user.PermCreate); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	if req.PgNum != nil && *req.PgNum <= 0 {
+		return nil, fmt.Errorf("%w: pg_num must be positive", types.ErrInvalidArg)
+	}
+	cmd := rados.MonCmd{Prefix: "osd pool create", Format: "json", Args: map[string]any{
+		"pool":      req.Name,
+		"pool_type": "replicated",
+	}}
+	if req.PoolType == pb.PoolType_erasure {
+		cmd.Args["pool_type"] = "erasure"
+		if req.Size != nil {
+			return nil, fmt.Errorf("%w: size cannot be set for erasure pool, it is defined by erasure code profile", types.ErrInvalidArg)
+		}
+		if req.ErasureCodeProfile != nil {
+			cmd.Args["erasure_code_profile"] = *req.ErasureCodeProfile
+		}
+	} else if req.ErasureCodeProfile != nil {
+		return nil, fmt.Errorf("%w: erasure_code_profile can be set only for erasure pool", types.ErrInvalidArg)
+	}
+	if req.PgNum != nil {
+		cmd.Args["pg_num"] = *req.PgNum
+		cmd.Args["pgp_num"] = *req.PgNum
+	}
+	if req.CrushRule != nil {
+		cmd.Args["rule"] = *req.CrushRule
+	}
+	if err := p.execMon(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	// size and min_size are applied after creation because "osd pool create" accepts them only in recent releases
+	if req.Size != nil {
+		if err := p.setPoolVar(ctx, req.Name, "size", strconv.Itoa(int(*req.Size))); err != nil {
+			return nil, err
+		}
+	}
+	if req.MinSize != nil {
+		if err := p.setPoolVar(ctx, req.Name, "min_size", strconv.Itoa(int(*req.MinSize))); err != nil {
+			return nil, err
+		}
+	}
+	if req.Application != nil {
+		if err := p.enableApplication(ctx, req.Name, *req.Application); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (p *poolAPI) UpdatePool(ctx context.Context, req *pb.UpdatePoolRequest) (*emptypb.Empty, error) {
+	if err := user.HasResourcePermissions(ctx, user.ScopePool, req.Name, user.PermUpdate); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	if req.PgNum != nil && *req.PgNum <= 0 {
+		return nil, fmt.Errorf("%w: pg_num must be positive", types.ErrInvalidArg)
+	}
+	if req.Size != nil {
+		if err := p.setPoolVar(ctx, req.Name, "size", strconv.Itoa(int(*req.Size))); err != nil {
+			return nil, err
+		}
+	}
+	if req.MinSize != nil {
+		if err := p.setPoolVar(ctx, req.Name, "min_size", strconv.Itoa(int(*req.MinSize))); err != nil {
+			return nil, err
+		}
+	}
+	if req.PgNum != nil {
+		if err := p.setPoolVar(ctx, req.Name, "pg_num", strconv.Itoa(int(*req.PgNum))); err != nil {
+			return nil, err
+		}
+	}
+	if req.CrushRule != nil {
+		if err := p.setPoolVar(ctx, req.Name, "crush_rule", *req.CrushRule); err != nil {
+			return nil, err
+		}
+	}
+	if req.Application != nil {
+		if err := p.enableApplication(ctx, req.Name, *req.Application); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (p *poolAPI) DeletePool(ctx context.Context, req *pb.DeletePoolRequest) (*pb.Operation, error) {
+	if err := user.HasResourcePermissions(ctx, user.ScopePool, req.Name, user.PermDelete); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", types.ErrInvalidArg)
+	}
+	if !req.Confirm {
+		return nil, fmt.Errorf("%w: pool deletion is irreversible and must be confirmed", types.ErrInvalidArg)
+	}
+	op, err := p.opSvc.Start(ctx, opPoolDelete, string(user.ScopePool), req.Name, poolDeleteParams{Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	return operationToPb(op), nil
+}
+
+type poolDeleteParams struct {
+	Name string `json:"name"`
+}
+
+// deletePool is pool.delete operation handler.
+func (p *poolAPI) deletePool(ctx context.Context, params json.RawMessage) error {
+	var req poolDeleteParams
+	if err := json.Unmarshal(params, &req); err != nil {
+		return err
+	}
+	return p.execMon(ctx, rados.MonCmd{Prefix: "osd pool delete", Format: "json", Args: map[string]any{
+		"pool":                        req.Name,
+		"pool2":                       req.Name,
+		"yes_i_really_really_mean_it": true,
+	}})
+}
+
+func (p *poolAPI) getPools(ctx context.Context) ([]types.OsdDumpPool, error) {
+	res, err := p.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "osd dump", Format: "json"})
+	if err != nil {
+		return nil, err
+	}
+	var osdDump types.CephOsdDumpResponse
+	if err := json.Unmarshal(res, &osdDump); err != nil {
+		return nil, err
+	}
+	return osdDump.Pools, nil
+}
+
+func (p *poolAPI) setPoolVar(ctx context.Context, pool, name, val string) error {
+	return p.execMon(ctx, rados.MonCmd{Prefix: "osd pool set", Format: "json", Args: map[string]any{
+		"pool": pool,
+		"var":  name,
+		"val":  val,
+	}})
+}
+
+func (p *poolAPI) enableApplication(ctx context.Context, pool, app string) error {
+	return p.execMon(ctx, rados.MonCmd{Prefix: "osd pool application enable", Format: "json", Args: map[string]any{
+		"pool": pool,
+		"app":  app,
+	}})
+}
+
+func (p *poolAPI) execMon(ctx context.Context, cmd rados.MonCmd) error {
+	_, err := p.radosSvc.ExecMon(ctx, cmd)
+	return err
+}
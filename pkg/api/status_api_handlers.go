@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/clyso/ceph-api/pkg/rados"
@@ -13,14 +14,16 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewStatusAPI(radosSvc *rados.Svc) pb.StatusServer {
+func NewStatusAPI(radosSvc *rados.Svc, pollInterval time.Duration) pb.StatusServer {
 	return &statusAPI{
 		radosSvc: radosSvc,
+		watcher:  newStatusWatcher(radosSvc, pollInterval),
 	}
 }
 
 type statusAPI struct {
 	radosSvc *rados.Svc
+	watcher  *statusWatcher
 }
 
 // GetCephReport implements pb.StatusServer.
@@ -29,8 +32,7 @@ func (s *statusAPI) GetCephReport(ctx context.Context, body *emptypb.Empty) (*st
 		return nil, err
 	}
 
-	const cmdTempl = `{"prefix": "report", "format": "json"}`
-	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
+	res, err := s.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "report", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +57,7 @@ func (s *statusAPI) GetCephStatus(ctx context.Context, body *emptypb.Empty) (*pb
 		return nil, err
 	}
 
-	const cmdTempl = `{"prefix": "status", "format": "json"}`
-	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
+	res, err := s.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "status", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -68,13 +69,32 @@ func (s *statusAPI) GetCephStatus(ctx context.Context, body *emptypb.Empty) (*pb
 	return &statusDump, nil
 }
 
+func (s *statusAPI) WatchCephStatus(_ *emptypb.Empty, stream pb.Status_WatchCephStatusServer) error {
+	ctx := stream.Context()
+	if err := user.HasPermissions(ctx, user.ScopeMonitor, user.PermRead); err != nil {
+		return err
+	}
+
+	updates, unsubscribe := s.watcher.subscribe(ctx)
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case status := <-updates:
+			if err := stream.Send(status); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (s *statusAPI) GetCephMonDump(ctx context.Context, req *emptypb.Empty) (*pb.CephMonDumpResponse, error) {
 	if err := user.HasPermissions(ctx, user.ScopeMonitor, user.PermRead); err != nil {
 		return nil, err
 	}
 
-	const cmdTempl = `{"prefix": "mon dump", "format": "json"}`
-	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
+	res, err := s.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "mon dump", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +130,7 @@ func (s *statusAPI) GetCephOsdDump(ctx context.Context, body *emptypb.Empty) (*p
 		return nil, err
 	}
 
-	const cmdTempl = `{"prefix": "osd dump", "format": "json"}`
-	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
+	res, err := s.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "osd dump", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -131,8 +150,7 @@ func (s *statusAPI) GetCephPgDump(ctx context.Context, body *emptypb.Empty) (*pb
 		return nil, err
 	}
 
-	const cmdTempl = `{"prefix": "pg dump", "format": "json"}`
-	res, err := s.radosSvc.ExecMon(ctx, cmdTempl)
+	res, err := s.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "pg dump", Format: "json"})
 	if err != nil {
 		return nil, err
 	}
@@ -151,69 +169,7 @@ func convertToPbGetCephOsdDumpResponse(osdDump types.CephOsdDumpResponse) *pb.Ge
 	// Convert pools
 	var osdDumpPools []*pb.OsdDumpPool
 	for _, pool := range osdDump.Pools {
-		osdDumpPools = append(osdDumpPools, &pb.OsdDumpPool{
-			Pool:                              pool.Pool,
-			PoolName:                          pool.PoolName,
-			CreateTime:                        pool.CreateTime.Timestamp,
-			Flags:                             pool.Flags,
-			FlagsNames:                        pool.FlagsNames,
-			Type:                              pool.Type,
-			Size:                              pool.Size,
-			MinSize:                           pool.MinSize,
-			CrushRule:                         pool.CrushRule,
-			PeeringCrushBucketCount:           pool.PeeringCrushBucketCount,
-			PeeringCrushBucketTarget:          pool.PeeringCrushBucketTarget,
-			PeeringCrushBucketBarrier:         pool.PeeringCrushBucketBarrier,
-			PeeringCrushBucketMandatoryMember: pool.PeeringCrushBucketMandatoryMember,
-			ObjectHash:                        pool.ObjectHash,
-			PgAutoscaleMode:                   pool.PgAutoscaleMode,
-			PgNum:                             pool.PgNum,
-			PgPlacementNum:                    pool.PgPlacementNum,
-			PgPlacementNumTarget:              pool.PgPlacementNumTarget,
-			PgNumTarget:                       pool.PgNumTarget,
-			PgNumPending:                      pool.PgNumPending,
-			LastPgMergeMeta:                   pool.LastPgMergeMeta,
-			LastChange:                        pool.LastChange,
-			LastForceOpResend:                 pool.LastForceOpResend,
-			LastForceOpResendPrenautilus:      pool.LastForceOpResendPrenautilus,
-			LastForceOpResendPreluminous:      pool.LastForceOpResendPreluminous,
-			Auid:                              pool.Auid,
-			SnapMode:                          pool.SnapMode,
-			SnapSeq:                           pool.SnapSeq,
-			SnapEpoch:                         pool.SnapEpoch,
-			PoolSnaps:                         pool.PoolSnaps,
-			RemovedSnaps:                      pool.RemovedSnaps,
-			QuotaMaxBytes:                     pool.QuotaMaxBytes,
-			QuotaMaxObjects:                   pool.QuotaMaxObjects,
-			Tiers:                             pool.Tiers,
-			TierOf:                            pool.TierOf,
-			ReadTier:                          pool.ReadTier,
-			WriteTier:                         pool.WriteTier,
-			CacheMode:                         pool.CacheMode,
-			TargetMaxBytes:                    pool.TargetMaxBytes,
-			TargetMaxObjects:                  pool.TargetMaxObjects,
-			CacheTargetDirtyRatioMicro:        pool.CacheTargetDirtyRatioMicro,
-			CacheTargetDirtyHighRatioMicro:    pool.CacheTargetDirtyHighRatioMicro,
-			CacheTargetFullRatioMicro:         pool.CacheTargetFullRatioMicro,
-			CacheMinFlushAge:                  pool.CacheMinFlushAge,
-			CacheMinEvictAge:                  pool.CacheMinEvictAge,
-			ErasureCodeProfile:                pool.ErasureCodeProfile,
-			HitSetParams:                      pool.HitSetParams,
-			HitSetPeriod:                      pool.HitSetPeriod,
-			HitSetCount:                       pool.HitSetCount,
-			UseGmtHitset:                      pool.UseGmtHitset,
-			MinReadRecencyForPromote:          pool.MinReadRecencyForPromote,
-			MinWriteRecencyForPromote:         pool.MinWriteRecencyForPromote,
-			HitSetGradeDecayRate:              pool.HitSetGradeDecayRate,
-			HitSetSearchLastN:                 pool.HitSetSearchLastN,
-			GradeTable:                        pool.GradeTable,
-			StripeWidth:                       pool.StripeWidth,
-			ExpectedNumObjects:                pool.ExpectedNumObjects,
-			FastRead:                          pool.FastRead,
-			Options:                           pool.Options,
-			ApplicationMetadata:               pool.ApplicationMetadata,
-			ReadBalance:                       pool.ReadBalance,
-		})
+		osdDumpPools = append(osdDumpPools, convertToPbOsdDumpPool(pool))
 	}
 
 	blocklistPb := make(map[string]*timestamppb.Timestamp, len(osdDump.Blocklist))
@@ -358,3 +314,69 @@ func convertToPbGetCephPgDumpReponse(pgDump *types.PgDumpResponse) *pb.GetCephPg
 		PgMap:   pgMap,
 	}
 }
+
+func convertToPbOsdDumpPool(pool types.OsdDumpPool) *pb.OsdDumpPool {
+	return &pb.OsdDumpPool{
+		Pool:                              pool.Pool,
+		PoolName:                          pool.PoolName,
+		CreateTime:                        pool.CreateTime.Timestamp,
+		Flags:                             pool.Flags,
+		FlagsNames:                        pool.FlagsNames,
+		Type:                              pool.Type,
+		Size:                              pool.Size,
+		MinSize:                           pool.MinSize,
+		CrushRule:                         pool.CrushRule,
+		PeeringCrushBucketCount:           pool.PeeringCrushBucketCount,
+		PeeringCrushBucketTarget:          pool.PeeringCrushBucketTarget,
+		PeeringCrushBucketBarrier:         pool.PeeringCrushBucketBarrier,
+		PeeringCrushBucketMandatoryMember: pool.PeeringCrushBucketMandatoryMember,
+		ObjectHash:                        pool.ObjectHash,
+		PgAutoscaleMode:                   pool.PgAutoscaleMode,
+		PgNum:                             pool.PgNum,
+		PgPlacementNum:                    pool.PgPlacementNum,
+		PgPlacementNumTarget:              pool.PgPlacementNumTarget,
+		PgNumTarget:                       pool.PgNumTarget,
+		PgNumPending:                      pool.PgNumPending,
+		LastPgMergeMeta:                   pool.LastPgMergeMeta,
+		LastChange:                        pool.LastChange,
+		LastForceOpResend:                 pool.LastForceOpResend,
+		LastForceOpResendPrenautilus:      pool.LastForceOpResendPrenautilus,
+		LastForceOpResendPreluminous:      pool.LastForceOpResendPreluminous,
+		Auid:                              pool.Auid,
+		SnapMode:                          pool.SnapMode,
+		SnapSeq:                           pool.SnapSeq,
+		SnapEpoch:                         pool.SnapEpoch,
+		PoolSnaps:                         pool.PoolSnaps,
+		RemovedSnaps:                      pool.RemovedSnaps,
+		QuotaMaxBytes:                     pool.QuotaMaxBytes,
+		QuotaMaxObjects:                   pool.QuotaMaxObjects,
+		Tiers:                             pool.Tiers,
+		TierOf:                            pool.TierOf,
+		ReadTier:                          pool.ReadTier,
+		WriteTier:                         pool.WriteTier,
+		CacheMode:                         pool.CacheMode,
+		TargetMaxBytes:                    pool.TargetMaxBytes,
+		TargetMaxObjects:                  pool.TargetMaxObjects,
+		CacheTargetDirtyRatioMicro:        pool.CacheTargetDirtyRatioMicro,
+		CacheTargetDirtyHighRatioMicro:    pool.CacheTargetDirtyHighRatioMicro,
+		CacheTargetFullRatioMicro:         pool.CacheTargetFullRatioMicro,
+		CacheMinFlushAge:                  pool.CacheMinFlushAge,
+		CacheMinEvictAge:                  pool.CacheMinEvictAge,
+		ErasureCodeProfile:                pool.ErasureCodeProfile,
+		HitSetParams:                      pool.HitSetParams,
+		HitSetPeriod:                      pool.HitSetPeriod,
+		HitSetCount:                       pool.HitSetCount,
+		UseGmtHitset:                      pool.UseGmtHitset,
+		MinReadRecencyForPromote:          pool.MinReadRecencyForPromote,
+		MinWriteRecencyForPromote:         pool.MinWriteRecencyForPromote,
+		HitSetGradeDecayRate:              pool.HitSetGradeDecayRate,
+		HitSetSearchLastN:                 pool.HitSetSearchLastN,
+		GradeTable:                        pool.GradeTable,
+		StripeWidth:                       pool.StripeWidth,
+		ExpectedNumObjects:                pool.ExpectedNumObjects,
+		FastRead:                          pool.FastRead,
+		Options:                           pool.Options,
+		ApplicationMetadata:               pool.ApplicationMetadata,
+		ReadBalance:                       pool.ReadBalance,
+	}
+}
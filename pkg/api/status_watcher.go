@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultStatusPollInterval = 5 * time.Second
+
+// statusWatcher polls ceph status on behalf of all WatchCephStatus subscribers.
+// Polling starts with the first subscriber and stops when the last one leaves.
+type statusWatcher struct {
+	radosSvc *rados.Svc
+	interval time.Duration
+
+	mu     sync.Mutex
+	subs   map[chan *pb.GetCephStatusResponse]struct{}
+	last   *pb.GetCephStatusResponse
+	cancel context.CancelFunc
+}
+
+func newStatusWatcher(radosSvc *rados.Svc, interval time.Duration) *statusWatcher {
+	if interval <= 0 {
+		interval = defaultStatusPollInterval
+	}
+	return &statusWatcher{
+		radosSvc: radosSvc,
+		interval: interval,
+		subs:     map[chan *pb.GetCephStatusResponse]struct{}{},
+	}
+}
+
+// subscribe returns channel with status updates and func to unsubscribe.
+// Channel receives last known status right away if it is available.
+// Slow subscribers skip intermediate updates and receive only the latest status.
+func (w *statusWatcher) subscribe(ctx context.Context) (<-chan *pb.GetCephStatusResponse, func()) {
+	ch := make(chan *pb.GetCephStatusResponse, 1)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subs[ch] = struct{}{}
+	if w.last != nil {
+		ch <- w.last
+	}
+	if w.cancel == nil {
+		// poller must outlive the request of the first subscriber
+		pollCtx, cancel := context.WithCancel(zerolog.Ctx(ctx).WithContext(context.Background()))
+		w.cancel = cancel
+		go w.poll(pollCtx)
+	}
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subs, ch)
+		if len(w.subs) == 0 && w.cancel != nil {
+			w.cancel()
+			w.cancel = nil
+			w.last = nil
+		}
+	}
+}
+
+func (w *statusWatcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *statusWatcher) update(ctx context.Context) {
+	res, err := w.radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "status", Format: "json"})
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to poll ceph status")
+		return
+	}
+	var status pb.GetCephStatusResponse
+	if err := json.Unmarshal(res, &status); err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to parse ceph status")
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if ctx.Err() != nil {
+		// poller was stopped while status was fetched
+		return
+	}
+	if !statusChanged(w.last, &status) {
+		return
+	}
+	w.last = &status
+	for ch := range w.subs {
+		// drop stale update if subscriber has not read it yet
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- w.last
+	}
+}
+
+// statusChanged reports whether health, pg states, osd or mon map are different.
+func statusChanged(prev, cur *pb.GetCephStatusResponse) bool {
+	if prev == nil {
+		return true
+	}
+	if !proto.Equal(prev.Health, cur.Health) ||
+		!proto.Equal(prev.Osdmap, cur.Osdmap) ||
+		!proto.Equal(prev.Monmap, cur.Monmap) {
+		return true
+	}
+	prevPgs, curPgs := prev.GetPgmap().GetPgsByState(), cur.GetPgmap().GetPgsByState()
+	if len(prevPgs) != len(curPgs) {
+		return true
+	}
+	for i := range prevPgs {
+		if !proto.Equal(prevPgs[i], curPgs[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+)
+
+// delay before reloading changed files, to not load certificate and key in the middle of update
+const tlsReloadDelay = time.Second
+
+// TLSCerts holds server TLS certificate and CA bundles loaded from config files.
+// Files are reloaded on change by Watch. New TLS handshakes use reloaded certificates, existing connections are not affected.
+type TLSCerts struct {
+	conf Config
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	// CA bundle to verify server certificate by gateway. Nil if not configured.
+	rootCAs *x509.CertPool
+	// CA bundle to verify grpc client certificates. Nil if not configured.
+	clientCAs *x509.CertPool
+}
+
+// NewTLSCerts loads certificates from config files. Self-signed certificate is generated if certificate file is not set.
+func NewTLSCerts(conf Config) (*TLSCerts, error) {
+	if (conf.CertFile == "") != (conf.KeyFile == "") {
+		return nil, fmt.Errorf("%w: both api certFile and keyFile must be set", types.ErrInvalidConfig)
+	}
+	c := &TLSCerts{conf: conf}
+	if conf.CertFile == "" {
+		cert, err := genX509KeyPair()
+		if err != nil {
+			return nil, err
+		}
+		c.cert = &cert
+	}
+	if _, err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ServerConfig returns TLS config for API listener. If withClientCAs is set and client CA bundle is configured,
+// client certificates are requested and verified.
+func (c *TLSCerts) ServerConfig(withClientCAs bool) *tls.Config {
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			return c.cert, nil
+		},
+	}
+	if withClientCAs && c.conf.ClientCAFile != "" {
+		conf.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			res := conf.Clone()
+			res.GetConfigForClient = nil
+			c.mu.RLock()
+			res.ClientCAs = c.clientCAs
+			c.mu.RUnlock()
+			// clients without certificate still can authenticate with bearer token
+			res.ClientAuth = tls.VerifyClientCertIfGiven
+			return res, nil
+		}
+	}
+	return conf
+}
+
+// GatewayConfig returns TLS config for REST gateway connection to local grpc server.
+// Server certificate is verified against configured CA bundle or must be equal to current server certificate.
+func (c *TLSCerts) GatewayConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// gateway connects to local address which is not in certificate SANs. Certificate is verified in VerifyConnection.
+		InsecureSkipVerify: true, //nolint: gosec
+		VerifyConnection:   c.verifyServer,
+	}
+}
+
+func (c *TLSCerts) verifyServer(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("grpc server certificate is missing")
+	}
+	c.mu.RLock()
+	roots, cert := c.rootCAs, c.cert
+	c.mu.RUnlock()
+	if roots == nil {
+		if !bytes.Equal(state.PeerCertificates[0].Raw, cert.Certificate[0]) {
+			return errors.New("grpc server certificate does not match api certificate")
+		}
+		return nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, ic := range state.PeerCertificates[1:] {
+		intermediates.AddCert(ic)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+// Watch reloads certificates when config files change. Blocks until context is cancelled.
+func (c *TLSCerts) Watch(ctx context.Context) error {
+	files := c.files()
+	if len(files) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	// watch directories instead of files to handle files replaced by rename or symlink swap, e.g. kubernetes secret update
+	dirs := map[string]struct{}{}
+	for _, f := range files {
+		dirs[filepath.Dir(f)] = struct{}{}
+	}
+	for dir := range dirs {
+		if err = watcher.Add(dir); err != nil {
+			return fmt.Errorf("%w: unable to watch tls files in %s", err, dir)
+		}
+	}
+	timer := time.NewTimer(tlsReloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			timer.Reset(tlsReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			zerolog.Ctx(ctx).Err(err).Msg("tls files watch error")
+		case <-timer.C:
+			changed, err := c.reload()
+			if err != nil {
+				zerolog.Ctx(ctx).Err(err).Msg("unable to reload tls files. Previous certificates are used")
+				continue
+			}
+			if changed {
+				zerolog.Ctx(ctx).Info().Msg("tls certificates reloaded")
+			}
+		}
+	}
+}
+
+func (c *TLSCerts) files() []string {
+	var res []string
+	for _, f := range []string{c.conf.CertFile, c.conf.KeyFile, c.conf.CAFile, c.conf.ClientCAFile} {
+		if f != "" {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// reload loads configured files. Certificates are replaced only if all files are valid.
+// Returns true if any certificate was changed.
+func (c *TLSCerts) reload() (bool, error) {
+	c.mu.RLock()
+	cert, rootCAs, clientCAs := c.cert, c.rootCAs, c.clientCAs
+	c.mu.RUnlock()
+
+	changed := false
+	if c.conf.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(c.conf.CertFile, c.conf.KeyFile)
+		if err != nil {
+			return false, fmt.Errorf("%w: unable to load tls certificate", err)
+		}
+		if cert == nil || !bytes.Equal(cert.Certificate[0], loaded.Certificate[0]) {
+			cert, changed = &loaded, true
+		}
+	}
+	if c.conf.CAFile != "" {
+		loaded, err := loadCertPool(c.conf.CAFile)
+		if err != nil {
+			return false, err
+		}
+		if rootCAs == nil || !rootCAs.Equal(loaded) {
+			rootCAs, changed = loaded, true
+		}
+	}
+	if c.conf.ClientCAFile != "" {
+		loaded, err := loadCertPool(c.conf.ClientCAFile)
+		if err != nil {
+			return false, err
+		}
+		if clientCAs == nil || !clientCAs.Equal(loaded) {
+			clientCAs, changed = loaded, true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	c.mu.Lock()
+	c.cert, c.rootCAs, c.clientCAs = cert, rootCAs, clientCAs
+	c.mu.Unlock()
+	return true, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	caPEM, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read CA file", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("%w: no certificates found in CA file %q", types.ErrInvalidConfig, file)
+	}
+	return pool, nil
+}
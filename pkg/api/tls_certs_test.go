@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCertPEM(t *testing.T, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, []byte, []byte) {
+	t.Helper()
+	r := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	r.NoError(err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	r.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	r.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	r.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	r.NoError(err)
+	return cert, key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestTLSCerts_Reload(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	ca, caKey, caPEM, _ := testCertPEM(t, "ca", nil, nil)
+	cert1, _, cert1PEM, key1PEM := testCertPEM(t, "server-1", ca, caKey)
+	cert2, _, cert2PEM, key2PEM := testCertPEM(t, "server-2", ca, caKey)
+	_, _, otherPEM, otherKeyPEM := testCertPEM(t, "self-signed", nil, nil)
+
+	conf := Config{
+		Secure:   true,
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+	r.NoError(os.WriteFile(conf.CAFile, caPEM, 0o600))
+	r.NoError(os.WriteFile(conf.CertFile, cert1PEM, 0o600))
+	r.NoError(os.WriteFile(conf.KeyFile, key1PEM, 0o600))
+
+	certs, err := NewTLSCerts(conf)
+	r.NoError(err)
+	current := func() *x509.Certificate {
+		c, err := certs.ServerConfig(true).GetCertificate(&tls.ClientHelloInfo{})
+		r.NoError(err)
+		leaf, err := x509.ParseCertificate(c.Certificate[0])
+		r.NoError(err)
+		return leaf
+	}
+	r.Equal(cert1.Raw, current().Raw)
+	r.NoError(certs.GatewayConfig().VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert1}}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go certs.Watch(ctx)
+	// wait for watcher to start
+	time.Sleep(100 * time.Millisecond)
+
+	// rotated certificate is used for new handshakes
+	r.NoError(os.WriteFile(conf.CertFile, cert2PEM, 0o600))
+	r.NoError(os.WriteFile(conf.KeyFile, key2PEM, 0o600))
+	r.Eventually(func() bool {
+		return string(current().Raw) == string(cert2.Raw)
+	}, 5*time.Second, 50*time.Millisecond)
+	r.NoError(certs.GatewayConfig().VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert2}}))
+
+	// invalid files are ignored and previous certificate is kept
+	r.NoError(os.WriteFile(conf.CertFile, otherPEM, 0o600))
+	time.Sleep(2 * tlsReloadDelay)
+	r.Equal(cert2.Raw, current().Raw)
+
+	// certificate not issued by CA is not trusted by gateway
+	r.NoError(os.WriteFile(conf.KeyFile, otherKeyPEM, 0o600))
+	r.Eventually(func() bool {
+		return current().Subject.CommonName == "self-signed"
+	}, 5*time.Second, 50*time.Millisecond)
+	r.Error(certs.GatewayConfig().VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{current()}}))
+}
+
+func TestTLSCerts_SelfSigned(t *testing.T) {
+	r := require.New(t)
+	certs, err := NewTLSCerts(Config{Secure: true})
+	r.NoError(err)
+	c, err := certs.ServerConfig(false).GetCertificate(&tls.ClientHelloInfo{})
+	r.NoError(err)
+	leaf, err := x509.ParseCertificate(c.Certificate[0])
+	r.NoError(err)
+
+	// gateway trusts only current server certificate
+	r.NoError(certs.GatewayConfig().VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}))
+	other, _, _, _ := testCertPEM(t, "other", nil, nil)
+	r.Error(certs.GatewayConfig().VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{other}}))
+
+	_, err = NewTLSCerts(Config{Secure: true, CertFile: "tls.crt"})
+	r.Error(err, "key file is required")
+}
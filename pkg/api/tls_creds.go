@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+
+	"github.com/soheilhy/cmux"
+	"google.golang.org/grpc/credentials"
+)
+
+// tlsListenerCreds exposes TLS connection state to grpc server when TLS is terminated by listener.
+// TLS cannot be handled by grpc server itself, because grpc and http share the same TLS listener with cmux.
+// Connection state is used to authenticate clients by certificate.
+type tlsListenerCreds struct{}
+
+var _ credentials.TransportCredentials = tlsListenerCreds{}
+
+func (tlsListenerCreds) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("tlsListenerCreds: client handshake is not supported")
+}
+
+func (tlsListenerCreds) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tlsConn := unwrapTLSConn(conn)
+	if tlsConn == nil {
+		return nil, nil, errors.New("tlsListenerCreds: not a tls connection")
+	}
+	// no-op if handshake was already done by cmux reading from connection
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, nil, err
+	}
+	return conn, credentials.TLSInfo{
+		State:          tlsConn.ConnectionState(),
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+	}, nil
+}
+
+func (tlsListenerCreds) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls", SecurityVersion: "1.2"}
+}
+
+func (c tlsListenerCreds) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (tlsListenerCreds) OverrideServerName(string) error {
+	return nil
+}
+
+func unwrapTLSConn(conn net.Conn) *tls.Conn {
+	switch c := conn.(type) {
+	case *tls.Conn:
+		return c
+	case *cmux.MuxConn:
+		return unwrapTLSConn(c.Conn)
+	default:
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	xctx "github.com/clyso/ceph-api/pkg/ctx"
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -52,11 +53,21 @@ func roleFromPb(r *pb.Role) user.Role {
 		}
 	}
 
+	resourcePermissions := make([]user.ResourcePermission, len(r.ResourcePermissions))
+	for i, rp := range r.ResourcePermissions {
+		resourcePermissions[i] = user.ResourcePermission{
+			Scope:       rp.Scope,
+			Resources:   rp.Resources,
+			Permissions: rp.Permissions,
+		}
+	}
+
 	return user.Role{
-		Name:        r.Name,
-		Description: r.Description,
-		IsSystem:    false,
-		Permissions: permissions,
+		Name:                r.Name,
+		Description:         r.Description,
+		IsSystem:            false,
+		Permissions:         permissions,
+		ResourcePermissions: resourcePermissions,
 	}
 }
 
@@ -79,7 +90,9 @@ func (u *usersAPI) GetRole(ctx context.Context, req *pb.GetRoleReq) (*pb.Role, e
 	if err != nil {
 		return nil, err
 	}
-	return roleToPb(role), nil
+	res := roleToPb(role)
+	setETag(ctx, res.GetVersion())
+	return res, nil
 }
 
 func roleToPb(r user.Role) *pb.Role {
@@ -90,10 +103,20 @@ func roleToPb(r user.Role) *pb.Role {
 			permissions[p].Values = append(permissions[p].Values, structpb.NewStringValue(v))
 		}
 	}
+	resourcePermissions := make([]*pb.ResourcePermission, len(r.ResourcePermissions))
+	for i, rp := range r.ResourcePermissions {
+		resourcePermissions[i] = &pb.ResourcePermission{
+			Scope:       rp.Scope,
+			Resources:   rp.Resources,
+			Permissions: rp.Permissions,
+		}
+	}
 	return &pb.Role{
-		Name:              r.Name,
-		Description:       r.Description,
-		ScopesPermissions: permissions,
+		Name:                r.Name,
+		Description:         r.Description,
+		ScopesPermissions:   permissions,
+		ResourcePermissions: resourcePermissions,
+		Version:             proto.String(user.RoleVersion(r)),
 	}
 }
 
@@ -116,7 +139,7 @@ func (u *usersAPI) UpdateRole(ctx context.Context, req *pb.Role) (*emptypb.Empty
 	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermUpdate); err != nil {
 		return nil, err
 	}
-	err := u.svc.UpdateRole(ctx, roleFromPb(req))
+	err := u.svc.UpdateRole(ctx, roleFromPb(req), requestVersion(ctx, req.Version))
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +157,101 @@ func (u *usersAPI) UserChangePassword(ctx context.Context, req *pb.UserChangePas
 	return &emptypb.Empty{}, nil
 }
 
+func (u *usersAPI) EnrollTOTP(ctx context.Context, req *pb.GetUserReq) (*pb.EnrollTOTPResp, error) {
+	if xctx.GetUsername(ctx) != req.Username {
+		return nil, types.ErrAccessDenied
+	}
+	secret, uri, err := u.svc.EnrollTOTP(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EnrollTOTPResp{Secret: secret, Uri: uri}, nil
+}
+
+func (u *usersAPI) ActivateTOTP(ctx context.Context, req *pb.TOTPCodeReq) (*pb.RecoveryCodesResp, error) {
+	if xctx.GetUsername(ctx) != req.Username {
+		return nil, types.ErrAccessDenied
+	}
+	codes, err := u.svc.ActivateTOTP(ctx, req.Username, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (u *usersAPI) RegenerateRecoveryCodes(ctx context.Context, req *pb.TOTPCodeReq) (*pb.RecoveryCodesResp, error) {
+	if xctx.GetUsername(ctx) != req.Username {
+		return nil, types.ErrAccessDenied
+	}
+	codes, err := u.svc.RegenerateRecoveryCodes(ctx, req.Username, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (u *usersAPI) DisableTOTP(ctx context.Context, req *pb.TOTPCodeReq) (*emptypb.Empty, error) {
+	// user disables own MFA with second factor, admin can reset MFA of other users without it
+	self := xctx.GetUsername(ctx) == req.Username
+	if !self {
+		if err := user.HasPermissions(ctx, user.ScopeUser, user.PermUpdate); err != nil {
+			return nil, err
+		}
+	}
+	err := u.svc.DisableTOTP(ctx, req.Username, req.Code, self)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) GetEffectivePermissions(ctx context.Context, req *pb.GetUserReq) (*pb.EffectivePermissionsResp, error) {
+	// users can see own permissions
+	if xctx.GetUsername(ctx) != req.Username {
+		if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
+			return nil, err
+		}
+	}
+	roles, grants, err := u.svc.GetEffectivePermissions(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EffectivePermissionsResp{
+		Username:    req.Username,
+		Roles:       roles,
+		Permissions: grantsToPb(grants),
+	}, nil
+}
+
+func (u *usersAPI) CanI(ctx context.Context, req *pb.CanIReq) (*pb.CanIResp, error) {
+	decision, err := u.svc.CanI(ctx, user.Scope(req.Scope), req.Permission, req.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CanIResp{
+		Allowed:   decision.Allowed,
+		Reason:    decision.Reason,
+		Username:  xctx.GetUsername(ctx),
+		Roles:     xctx.GetRoles(ctx),
+		GrantedBy: grantsToPb(decision.GrantedBy),
+	}, nil
+}
+
+func grantsToPb(grants []user.PermissionGrant) []*pb.PermissionGrant {
+	res := make([]*pb.PermissionGrant, len(grants))
+	for i, g := range grants {
+		res[i] = &pb.PermissionGrant{
+			Scope:      g.Scope,
+			Permission: g.Permission,
+			Roles:      g.Roles,
+		}
+		if g.Resource != "" {
+			res[i].Resource = proto.String(g.Resource)
+		}
+	}
+	return res
+}
+
 func (u *usersAPI) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*emptypb.Empty, error) {
 	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermCreate); err != nil {
 		return nil, err
@@ -177,7 +295,9 @@ func (u *usersAPI) GetUser(ctx context.Context, req *pb.GetUserReq) (*pb.User, e
 	if err != nil {
 		return nil, err
 	}
-	return userToPb(usr), nil
+	res := userToPb(usr)
+	setETag(ctx, res.Version)
+	return res, nil
 }
 
 func userToPb(usr user.User) *pb.User {
@@ -189,6 +309,8 @@ func userToPb(usr user.User) *pb.User {
 		PwdUpdateRequired: usr.PwdUpdateRequired,
 		Roles:             usr.Roles,
 		Username:          usr.Username,
+		MfaEnabled:        usr.MFAEnabled(),
+		Version:           user.UserVersion(usr),
 	}
 	if usr.PwdExpirationDate != nil {
 		res.PwdExpirationDate = &timestamppb.Timestamp{Seconds: int64(*usr.PwdExpirationDate)}
@@ -228,7 +350,129 @@ func (u *usersAPI) UpdateUser(ctx context.Context, req *pb.CreateUserReq) (*empt
 		var expIn int = int(req.PwdExpirationDate.Seconds)
 		usr.PwdExpirationDate = &expIn
 	}
-	err := u.svc.UpdateUser(ctx, usr)
+	err := u.svc.UpdateUser(ctx, usr, requestVersion(ctx, req.Version))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) ListServiceAccounts(ctx context.Context, _ *emptypb.Empty) (*pb.ServiceAccountsResp, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
+		return nil, err
+	}
+	accounts, err := u.svc.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.ServiceAccount, len(accounts))
+	for i, a := range accounts {
+		res[i] = serviceAccountToPb(a)
+	}
+	return &pb.ServiceAccountsResp{ServiceAccounts: res}, nil
+}
+
+func (u *usersAPI) GetServiceAccount(ctx context.Context, req *pb.GetServiceAccountReq) (*pb.ServiceAccount, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
+		return nil, err
+	}
+	account, err := u.svc.GetServiceAccount(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return serviceAccountToPb(account), nil
+}
+
+func serviceAccountToPb(a user.ServiceAccount) *pb.ServiceAccount {
+	return &pb.ServiceAccount{
+		Name:        a.Name,
+		Description: a.Description,
+		Roles:       a.Roles,
+		Created:     &timestamppb.Timestamp{Seconds: int64(a.Created)},
+	}
+}
+
+func (u *usersAPI) CreateServiceAccount(ctx context.Context, req *pb.ServiceAccount) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermCreate); err != nil {
+		return nil, err
+	}
+	err := u.svc.CreateServiceAccount(ctx, user.ServiceAccount{
+		Name:        req.Name,
+		Description: req.Description,
+		Roles:       req.Roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) DeleteServiceAccount(ctx context.Context, req *pb.GetServiceAccountReq) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermDelete); err != nil {
+		return nil, err
+	}
+	err := u.svc.DeleteServiceAccount(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (u *usersAPI) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyReq) (*pb.CreateAPIKeyResp, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermCreate); err != nil {
+		return nil, err
+	}
+	key := user.APIKey{
+		Name:  req.Name,
+		Roles: req.Roles,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := int(req.ExpiresAt.Seconds)
+		key.ExpiresAt = &expiresAt
+	}
+	key, token, err := u.svc.CreateAPIKey(ctx, req.ServiceAccount, key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateAPIKeyResp{Key: apiKeyToPb(key), Token: token}, nil
+}
+
+func (u *usersAPI) ListAPIKeys(ctx context.Context, req *pb.GetServiceAccountReq) (*pb.APIKeysResp, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermRead); err != nil {
+		return nil, err
+	}
+	account, err := u.svc.GetServiceAccount(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*pb.APIKey, len(account.Keys))
+	for i, k := range account.Keys {
+		res[i] = apiKeyToPb(k)
+	}
+	return &pb.APIKeysResp{Keys: res}, nil
+}
+
+func apiKeyToPb(k user.APIKey) *pb.APIKey {
+	res := &pb.APIKey{
+		Id:      k.ID,
+		Name:    k.Name,
+		Roles:   k.Roles,
+		Created: &timestamppb.Timestamp{Seconds: int64(k.Created)},
+	}
+	if k.ExpiresAt != nil {
+		res.ExpiresAt = &timestamppb.Timestamp{Seconds: int64(*k.ExpiresAt)}
+	}
+	if k.LastUsed != nil {
+		res.LastUsed = &timestamppb.Timestamp{Seconds: int64(*k.LastUsed)}
+	}
+	return res
+}
+
+func (u *usersAPI) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyReq) (*emptypb.Empty, error) {
+	if err := user.HasPermissions(ctx, user.ScopeUser, user.PermDelete); err != nil {
+		return nil, err
+	}
+	err := u.svc.RevokeAPIKey(ctx, req.ServiceAccount, req.Id)
 	if err != nil {
 		return nil, err
 	}
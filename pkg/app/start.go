@@ -7,10 +7,13 @@ import (
 	"net/http"
 
 	"github.com/clyso/ceph-api/pkg/api"
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/clyso/ceph-api/pkg/auth"
+	"github.com/clyso/ceph-api/pkg/cephcmd"
 	"github.com/clyso/ceph-api/pkg/cephconfig"
 	"github.com/clyso/ceph-api/pkg/config"
 	"github.com/clyso/ceph-api/pkg/log"
+	"github.com/clyso/ceph-api/pkg/operation"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/trace"
 	"github.com/clyso/ceph-api/pkg/types"
@@ -57,7 +60,7 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 	}
 
 	clusterAPI := api.NewClusterAPI(radosSvc, configSvc)
-	userSvc, err := user.New(radosSvc)
+	userSvc, err := user.New(radosSvc, conf.App.Config)
 	if err != nil {
 		return err
 	}
@@ -81,7 +84,7 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 				Password: conf.App.AdminPassword,
 				Name:     util.StrPtr("ceph api default administrator"),
 				Enabled:  true,
-			})
+			}, "")
 			if err != nil {
 				return fmt.Errorf("%w: unable to update admin user", err)
 			}
@@ -91,36 +94,95 @@ func Start(ctx context.Context, conf config.Config, build config.Build) error {
 	}
 	usersAPI := api.NewUsersAPI(userSvc)
 
-	authServer, err := auth.NewServer(conf.Auth, userSvc)
+	authServer, err := auth.NewServer(ctx, conf.Auth, userSvc, radosSvc)
 	if err != nil {
 		return err
 	}
 	authAPI := api.NewAuthAPI(authServer)
 
 	server := util.NewServer()
+	err = server.Add("accessdb_watch", userSvc.WatchDB, nil)
+	if err != nil {
+		return err
+	}
 
 	crushRuleAPI := api.NewCrushRuleAPI(radosSvc)
 
-	statusAPI := api.NewStatusAPI(radosSvc)
+	statusAPI := api.NewStatusAPI(radosSvc, conf.Api.StatusPollInterval)
+
+	opSvc := operation.New(conf.Operation, radosSvc)
+	operationsAPI := api.NewOperationsAPI(opSvc)
+
+	poolAPI := api.NewPoolAPI(radosSvc, opSvc)
+
+	erasureCodeProfileAPI := api.NewErasureCodeProfileAPI(radosSvc)
+
+	osdAPI := api.NewOsdAPI(radosSvc, opSvc)
 
-	authChecker := auth.AuthFunc(userSvc, authServer.Provider(), authServer.GetPublicKey)
-	grpcServer := api.NewGrpcServer(conf.Api, clusterAPI, usersAPI, authAPI, crushRuleAPI, statusAPI, authChecker, tp, conf.Log)
+	logsAPI := api.NewLogsAPI(radosSvc, conf.Api.LogPollInterval)
+
+	clusterStateAPI := api.NewClusterStateAPI(clusterAPI, poolAPI, crushRuleAPI, configSvc)
+
+	// handlers are registered by API constructors above
+	err = server.Add("operations", opSvc.Run, nil)
+	if err != nil {
+		return err
+	}
+
+	cmdIndex, err := cephcmd.New(ctx, radosSvc, false)
+	if err != nil {
+		return err
+	}
+	commandAPI := api.NewCommandAPI(radosSvc, cmdIndex)
+
+	auditLog := audit.New(conf.Audit, radosSvc)
+	auditAPI := api.NewAuditAPI(auditLog)
+	err = server.Add("audit_retention", auditLog.RunRetention, nil)
+	if err != nil {
+		return err
+	}
+
+	if conf.Auth.ClientCert.Enabled {
+		if !conf.Api.Secure || conf.Api.ClientCAFile == "" {
+			return fmt.Errorf("%w: client certificate auth requires api secure and clientCAFile", types.ErrInvalidConfig)
+		}
+		if err = conf.Auth.ClientCert.Validate(); err != nil {
+			return err
+		}
+	}
+	authChecker := auth.AuthFunc(userSvc, authServer.Provider(), authServer.GetPublicKey, conf.Auth.ClientCert)
+	grpcServer := api.NewGrpcServer(conf.Api, clusterAPI, usersAPI, authAPI, crushRuleAPI, statusAPI, poolAPI, erasureCodeProfileAPI, osdAPI, logsAPI, clusterStateAPI, auditAPI, auditLog, operationsAPI, commandAPI, authChecker, tp, conf.Log)
 
 	var metricsHandler http.HandlerFunc
 	if conf.Metrics.Enabled {
 		metricsHandler = promhttp.Handler().ServeHTTP
 	}
 	oauthHandlers := map[string]http.HandlerFunc{
-		"/api/oauth/token":      authServer.TokenEndpoint,
-		"/api/oauth/auth":       authServer.AuthEndpoint,
-		"/api/oauth/revoke":     authServer.RevokeEndpoint,
-		"/api/oauth/introspect": authServer.IntrospectionEndpoint,
+		auth.TokenPath:      authServer.TokenEndpoint,
+		auth.AuthPath:       authServer.AuthEndpoint,
+		auth.RevokePath:     authServer.RevokeEndpoint,
+		auth.IntrospectPath: authServer.IntrospectionEndpoint,
+	}
+	oidcHandlers := map[string]http.HandlerFunc{
+		auth.DiscoveryPath: authServer.DiscoveryEndpoint,
+		auth.JWKSPath:      authServer.JWKSEndpoint,
+	}
+	var tlsCerts *api.TLSCerts
+	if conf.Api.Secure {
+		tlsCerts, err = api.NewTLSCerts(conf.Api)
+		if err != nil {
+			return err
+		}
+		err = server.Add("tls_reload", tlsCerts.Watch, nil)
+		if err != nil {
+			return err
+		}
 	}
-	httpServer, err := api.GRPCGateway(ctx, conf.Api, metricsHandler, oauthHandlers)
+	httpServer, err := api.GRPCGateway(ctx, conf.Api, tlsCerts, metricsHandler, oauthHandlers, oidcHandlers)
 	if err != nil {
 		return err
 	}
-	start, stop, err := api.Serve(ctx, conf.Api, grpcServer, httpServer)
+	start, stop, err := api.Serve(ctx, conf.Api, tlsCerts, grpcServer, httpServer)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// omap of this object holds audit log entries
+	logObject = "audit_log"
+
+	defaultLimit        = 100
+	maxLimit            = 1000
+	defaultTrimInterval = time.Hour
+	// number of omap keys read or removed at once
+	batchSize = 1000
+)
+
+// Entry is audit log record of API call.
+type Entry struct {
+	Time     time.Time `json:"time"`
+	Username string    `json:"username"`
+	// full grpc method name, e.g: /ceph.Pool/CreatePool
+	Method string `json:"method"`
+	// request message in JSON with redacted secrets
+	Request json.RawMessage `json:"request,omitempty"`
+	// grpc status code
+	Code    string `json:"code"`
+	Error   string `json:"error,omitempty"`
+	TraceID string `json:"trace_id,omitempty"`
+}
+
+type Filter struct {
+	From, To time.Time
+	Username string
+	Method   string
+	Limit    int
+	// key of the last entry from previous page
+	PageToken string
+}
+
+// Log stores audit entries in omap of RADOS object. Omap keys start with entry timestamp,
+// so entries are listed in chronological order and can be trimmed by time.
+type Log struct {
+	conf  Config
+	rados *rados.Svc
+}
+
+func New(conf Config, radosSvc *rados.Svc) *Log {
+	return &Log{conf: conf, rados: radosSvc}
+}
+
+func (l *Log) Enabled() bool {
+	return l.conf.Enabled
+}
+
+func (l *Log) Write(ctx context.Context, e Entry) error {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	suffix := make([]byte, 4)
+	if _, err = rand.Read(suffix); err != nil {
+		return err
+	}
+	// suffix makes keys of concurrent calls unique
+	key := timeKey(e.Time) + "." + hex.EncodeToString(suffix)
+	return l.rados.SetOmap(ctx, l.conf.Pool, l.conf.Namespace, logObject, map[string][]byte{key: val})
+}
+
+// List returns entries matching filter in chronological order.
+// Returned page token is not empty if there are more matching entries.
+func (l *Log) List(ctx context.Context, f Filter) ([]Entry, string, error) {
+	if !l.conf.Enabled {
+		return nil, "", fmt.Errorf("%w: audit log is disabled", types.ErrFailedPrecondition)
+	}
+	if f.Limit == 0 {
+		f.Limit = defaultLimit
+	}
+	if f.Limit < 0 || f.Limit > maxLimit {
+		return nil, "", fmt.Errorf("%w: limit must be between 1 and %d", types.ErrInvalidArg, maxLimit)
+	}
+	if !f.To.IsZero() && f.To.Before(f.From) {
+		return nil, "", fmt.Errorf("%w: to is before from", types.ErrInvalidArg)
+	}
+	startAfter := f.PageToken
+	if startAfter == "" && !f.From.IsZero() {
+		// keys of entries at from time have this prefix and sort after it
+		startAfter = timeKey(f.From)
+	}
+	var res []Entry
+	lastKey, done, more := "", false, false
+	for !done {
+		listed := 0
+		var decodeErr error
+		err := l.rados.ListOmap(ctx, l.conf.Pool, l.conf.Namespace, logObject, startAfter, batchSize, func(key string, value []byte) {
+			listed++
+			startAfter = key
+			if done || decodeErr != nil {
+				return
+			}
+			var e Entry
+			if decodeErr = json.Unmarshal(value, &e); decodeErr != nil {
+				decodeErr = fmt.Errorf("%w: unable to decode audit entry %q", decodeErr, key)
+				return
+			}
+			if !f.To.IsZero() && e.Time.After(f.To) {
+				done = true
+				return
+			}
+			if (f.Username != "" && e.Username != f.Username) || (f.Method != "" && e.Method != f.Method) {
+				return
+			}
+			if len(res) == f.Limit {
+				done, more = true, true
+				return
+			}
+			res = append(res, e)
+			lastKey = key
+		})
+		if errors.Is(err, types.RadosErrorNotFound) {
+			// no entries were written yet
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if decodeErr != nil {
+			return nil, "", decodeErr
+		}
+		if listed < batchSize {
+			break
+		}
+	}
+	if !more {
+		lastKey = ""
+	}
+	return res, lastKey, nil
+}
+
+// Trim removes entries written before given time. Returns number of removed entries.
+func (l *Log) Trim(ctx context.Context, before time.Time) (int, error) {
+	end := timeKey(before)
+	removed := 0
+	for {
+		var keys []string
+		err := l.rados.ListOmap(ctx, l.conf.Pool, l.conf.Namespace, logObject, "", batchSize, func(key string, _ []byte) {
+			if key < end {
+				keys = append(keys, key)
+			}
+		})
+		if errors.Is(err, types.RadosErrorNotFound) {
+			return removed, nil
+		}
+		if err != nil {
+			return removed, err
+		}
+		if len(keys) == 0 {
+			return removed, nil
+		}
+		if err = l.rados.RmOmapKeys(ctx, l.conf.Pool, l.conf.Namespace, logObject, keys); err != nil {
+			return removed, err
+		}
+		removed += len(keys)
+		if len(keys) < batchSize {
+			return removed, nil
+		}
+	}
+}
+
+// RunRetention periodically removes entries older than configured retention. Blocks until context is cancelled.
+func (l *Log) RunRetention(ctx context.Context) error {
+	if !l.conf.Enabled || l.conf.Retention <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+	interval := l.conf.TrimInterval
+	if interval <= 0 {
+		interval = defaultTrimInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		removed, err := l.Trim(ctx, time.Now().Add(-l.conf.Retention))
+		if err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to trim audit log")
+		} else if removed != 0 {
+			zerolog.Ctx(ctx).Info().Int("removed", removed).Msg("expired audit log entries removed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// timeKey returns fixed width key prefix, so keys are sorted by time.
+func timeKey(t time.Time) string {
+	return fmt.Sprintf("%019d", t.UnixNano())
+}
@@ -0,0 +1,141 @@
+//go:build mock
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestLog(t *testing.T) *Log {
+	t.Helper()
+	conn, err := rados.NewMockConn()
+	require.NoError(t, err)
+	radosSvc, err := rados.New(conn)
+	require.NoError(t, err)
+	return New(Config{Enabled: true, Pool: ".mgr", Namespace: "ceph-api"}, radosSvc)
+}
+
+func TestLog_List(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	l := newTestLog(t)
+
+	entries, next, err := l.List(ctx, Filter{})
+	r.NoError(err, "empty log")
+	r.Empty(entries)
+	r.Empty(next)
+
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < 10; i++ {
+		username := "alice"
+		if i%2 == 1 {
+			username = "bob"
+		}
+		r.NoError(l.Write(ctx, Entry{Time: start.Add(time.Duration(i) * time.Minute), Username: username, Method: "/ceph.Pool/CreatePool", Code: "OK"}))
+	}
+
+	entries, next, err = l.List(ctx, Filter{})
+	r.NoError(err)
+	r.Len(entries, 10)
+	r.Empty(next)
+	for i := 1; i < len(entries); i++ {
+		r.True(entries[i-1].Time.Before(entries[i].Time), "chronological order")
+	}
+
+	entries, _, err = l.List(ctx, Filter{Username: "bob"})
+	r.NoError(err)
+	r.Len(entries, 5)
+
+	entries, _, err = l.List(ctx, Filter{From: start.Add(2 * time.Minute), To: start.Add(4 * time.Minute)})
+	r.NoError(err)
+	r.Len(entries, 3, "from and to are inclusive")
+	r.True(entries[0].Time.Equal(start.Add(2 * time.Minute)))
+
+	entries, _, err = l.List(ctx, Filter{Method: "/ceph.Pool/DeletePool"})
+	r.NoError(err)
+	r.Empty(entries)
+
+	// paging
+	var all []Entry
+	filter := Filter{Username: "alice", Limit: 2}
+	for {
+		entries, next, err = l.List(ctx, filter)
+		r.NoError(err)
+		all = append(all, entries...)
+		if next == "" {
+			break
+		}
+		filter.PageToken = next
+	}
+	r.Len(all, 5)
+	for _, e := range all {
+		r.Equal("alice", e.Username)
+	}
+
+	_, _, err = l.List(ctx, Filter{Limit: maxLimit + 1})
+	r.Error(err)
+
+	// trim
+	removed, err := l.Trim(ctx, start.Add(5*time.Minute))
+	r.NoError(err)
+	r.Equal(5, removed)
+	entries, _, err = l.List(ctx, Filter{})
+	r.NoError(err)
+	r.Len(entries, 5)
+	r.True(entries[0].Time.Equal(start.Add(5 * time.Minute)))
+}
+
+func TestLog_Disabled(t *testing.T) {
+	l := newTestLog(t)
+	l.conf.Enabled = false
+	_, _, err := l.List(context.Background(), Filter{})
+	require.Error(t, err)
+}
+
+func Test_sanitize(t *testing.T) {
+	r := require.New(t)
+	raw := sanitize(&pb.CreateUserReq{Username: "alice", Password: "Secret-Pass-1", Roles: []string{"read-only"}})
+	var got map[string]any
+	r.NoError(json.Unmarshal(raw, &got))
+	r.Equal("alice", got["username"])
+	r.Equal(redacted, got["password"])
+	r.NotContains(string(raw), "Secret-Pass-1")
+
+	raw = sanitize(&pb.LoginReq{Username: "alice", Password: "Secret-Pass-1", Otp: "123456"})
+	r.NotContains(string(raw), "Secret-Pass-1")
+	r.NotContains(string(raw), "123456")
+
+	// bytes fields are redacted regardless of name
+	keyring := "[client.foo]\n\tkey = AQBSdFhbAAAAABAAx6qHrGNrXJ4n0wH8xlCTqQ==\n"
+	raw = sanitize(&pb.CreateClusterUserReq{UserEntity: "client.foo", ImportData: []byte(keyring)})
+	r.NoError(json.Unmarshal(raw, &got))
+	r.Equal("client.foo", got["user_entity"])
+	r.Equal(redacted, got["import_data"])
+	r.NotContains(string(raw), base64.StdEncoding.EncodeToString([]byte(keyring)))
+
+	args, err := structpb.NewStruct(map[string]any{"key": "mgr/ceph-api/accessdb", "val": "secret-value"})
+	r.NoError(err)
+	raw = sanitize(&pb.ExecCommandRequest{Prefix: "config-key set", Args: args})
+	r.Contains(string(raw), "config-key set")
+	r.NotContains(string(raw), "secret-value")
+	r.NotContains(string(raw), "mgr/ceph-api/accessdb")
+}
+
+func Test_isReadOnly(t *testing.T) {
+	r := require.New(t)
+	r.True(isReadOnly("/ceph.Pool/ListPools"))
+	r.True(isReadOnly("/ceph.Users/GetUser"))
+	r.True(isReadOnly("/ceph.ClusterState/Plan"))
+	r.False(isReadOnly("/ceph.Pool/CreatePool"))
+	r.False(isReadOnly("/ceph.ClusterState/Apply"))
+	r.False(isReadOnly("/ceph.Auth/Login"))
+}
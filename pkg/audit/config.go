@@ -0,0 +1,14 @@
+package audit
+
+import "time"
+
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// RADOS pool and namespace of audit log object. Pool must exist.
+	Pool      string `yaml:"pool"`
+	Namespace string `yaml:"namespace"`
+	// Entries older than retention are removed. Set 0 to keep entries forever.
+	Retention time.Duration `yaml:"retention"`
+	// How often expired entries are removed.
+	TrimInterval time.Duration `yaml:"trimInterval"`
+}
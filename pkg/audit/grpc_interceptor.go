@@ -0,0 +1,167 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "***"
+
+var (
+	// methods with these name prefixes don't change cluster or api state and are not audited
+	readOnlyPrefixes = []string{"Get", "List", "Search", "Dump", "Export", "Plan", "Check", "CanI", "Watch", "Tail"}
+	// values of request fields with these names are redacted. Bytes fields are always redacted,
+	// because they carry opaque payloads like keyrings.
+	sensitiveFields = map[string]struct{}{
+		"password": {}, "old_password": {}, "new_password": {}, "otp": {}, "code": {}, "token": {}, "secret": {}, "key": {},
+		// keyring of imported ceph user
+		"import_data": {},
+		// config-key value in command arguments
+		"val": {},
+	}
+)
+
+// UnaryInterceptor writes audit log entry for every mutating API call.
+// Must be added after auth and trace interceptors to get caller and trace ID, and before error interceptor to get grpc code.
+func UnaryInterceptor(l *Log) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !l.Enabled() || isReadOnly(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		st := status.Convert(err)
+		e := Entry{
+			Time:     start,
+			Username: xctx.GetUsername(ctx),
+			Method:   info.FullMethod,
+			Request:  sanitize(req),
+			Code:     st.Code().String(),
+			TraceID:  xctx.GetTraceID(ctx),
+		}
+		if err != nil {
+			e.Error = st.Message()
+		}
+		if r, ok := req.(interface{ GetUsername() string }); ok && e.Username == "" {
+			// unauthenticated call, e.g. login
+			e.Username = r.GetUsername()
+		}
+		// audit failure should not fail already executed call
+		if wErr := l.Write(ctx, e); wErr != nil {
+			zerolog.Ctx(ctx).Err(wErr).Str("grpc_method", info.FullMethod).Msg("unable to write audit log entry")
+		}
+		return resp, err
+	}
+}
+
+func isReadOnly(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, p := range readOnlyPrefixes {
+		if strings.HasPrefix(method, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitize returns request JSON with redacted sensitive fields.
+func sanitize(req any) json.RawMessage {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	var val map[string]any
+	if err = json.Unmarshal(raw, &val); err != nil {
+		return nil
+	}
+	redactMessage(msg.ProtoReflect(), val)
+	res, err := json.Marshal(val)
+	if err != nil {
+		return nil
+	}
+	return res
+}
+
+// redactMessage redacts sensitive and bytes fields of message JSON.
+// Message descriptor is used to find bytes fields, because in JSON they are indistinguishable from strings.
+func redactMessage(msg protoreflect.Message, val map[string]any) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		fv, ok := val[name]
+		if !ok {
+			continue
+		}
+		if _, ok := sensitiveFields[name]; ok || fd.Kind() == protoreflect.BytesKind || (fd.IsMap() && fd.MapValue().Kind() == protoreflect.BytesKind) {
+			val[name] = redacted
+			continue
+		}
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			continue
+		}
+		if fd.IsMap() && fd.MapValue().Kind() != protoreflect.MessageKind {
+			continue
+		}
+		if fd.Message().FullName().Parent() == "google.protobuf" {
+			// well-known types, e.g: Struct with command arguments, have custom JSON form
+			val[name] = redact(fv)
+			continue
+		}
+		fieldVal := msg.Get(fd)
+		switch {
+		case fd.IsList():
+			items, _ := fv.([]any)
+			for j := 0; j < len(items) && j < fieldVal.List().Len(); j++ {
+				if m, ok := items[j].(map[string]any); ok {
+					redactMessage(fieldVal.List().Get(j).Message(), m)
+				}
+			}
+		case fd.IsMap():
+			entries, _ := fv.(map[string]any)
+			fieldVal.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				if m, ok := entries[k.String()].(map[string]any); ok {
+					redactMessage(v.Message(), m)
+				}
+				return true
+			})
+		default:
+			if m, ok := fv.(map[string]any); ok {
+				redactMessage(fieldVal.Message(), m)
+			}
+		}
+	}
+}
+
+func redact(val any) any {
+	switch v := val.(type) {
+	case map[string]any:
+		for k, fv := range v {
+			if _, ok := sensitiveFields[k]; ok {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redact(fv)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return val
+}
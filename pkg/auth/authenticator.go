@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/clyso/ceph-api/pkg/user"
+)
+
+// Authenticator verifies username and password of OAuth password grant.
+// Authenticators are tried in order until one of them succeeds.
+type Authenticator interface {
+	Authenticate(ctx context.Context, username, password string) error
+}
+
+// localAuthenticator verifies password stored in accessdb. Applies account lockout and password expiration.
+type localAuthenticator struct {
+	userSvc *user.Service
+}
+
+func (a *localAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	return a.userSvc.VerifyPassword(ctx, username, password)
+}
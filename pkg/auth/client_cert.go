@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// certificate fields which can be used as client identity
+const (
+	certIdentityCN    = "cn"
+	certIdentityDNS   = "dns"
+	certIdentityEmail = "email"
+	certIdentityURI   = "uri"
+)
+
+func (c *ClientCertConfig) Validate() error {
+	switch c.IdentityFrom {
+	case "", certIdentityCN, certIdentityDNS, certIdentityEmail, certIdentityURI:
+	default:
+		return fmt.Errorf("%w: unknown clientCert identityFrom %q", types.ErrInvalidConfig, c.IdentityFrom)
+	}
+	for _, m := range c.Mapping {
+		if m.Identity == "" || m.Account == "" {
+			return fmt.Errorf("%w: clientCert mapping requires identity and account", types.ErrInvalidConfig)
+		}
+	}
+	return nil
+}
+
+// clientCertAccount returns ceph-api account name of verified grpc client certificate.
+// Returns false if client did not present certificate or certificate identity is not mapped to account.
+func clientCertAccount(ctx context.Context, conf ClientCertConfig) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	identities := certIdentities(tlsInfo.State.VerifiedChains[0][0], conf.IdentityFrom)
+	for _, m := range conf.Mapping {
+		for _, id := range identities {
+			if m.Identity == id {
+				return m.Account, true
+			}
+		}
+	}
+	if !conf.AllowIdentityAsAccount || len(identities) == 0 {
+		return "", false
+	}
+	return identities[0], true
+}
+
+func certIdentities(cert *x509.Certificate, from string) []string {
+	switch from {
+	case certIdentityDNS:
+		return cert.DNSNames
+	case certIdentityEmail:
+		return cert.EmailAddresses
+	case certIdentityURI:
+		res := make([]string, 0, len(cert.URIs))
+		for _, u := range cert.URIs {
+			res = append(res, u.String())
+		}
+		return res
+	default:
+		if cert.Subject.CommonName == "" {
+			return nil
+		}
+		return []string{cert.Subject.CommonName}
+	}
+}
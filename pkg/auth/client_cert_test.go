@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientCertAccount(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "admin"}, DNSNames: []string{"backup.example.com"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+	tests := []struct {
+		name        string
+		conf        ClientCertConfig
+		wantAccount string
+		wantOK      bool
+	}{
+		{name: "not mapped", conf: ClientCertConfig{Mapping: []CertIdentity{{Identity: "other", Account: "other"}}}},
+		{name: "not mapped identity as account", conf: ClientCertConfig{AllowIdentityAsAccount: true}, wantAccount: "admin", wantOK: true},
+		{name: "mapped cn", conf: ClientCertConfig{Mapping: []CertIdentity{{Identity: "admin", Account: "cert-admin"}}}, wantAccount: "cert-admin", wantOK: true},
+		{name: "mapped dns", conf: ClientCertConfig{IdentityFrom: certIdentityDNS, Mapping: []CertIdentity{{Identity: "backup.example.com", Account: "backup"}}}, wantAccount: "backup", wantOK: true},
+		{name: "no identity", conf: ClientCertConfig{IdentityFrom: certIdentityEmail, AllowIdentityAsAccount: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, ok := clientCertAccount(ctx, tt.conf)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantAccount, account)
+		})
+	}
+
+	_, ok := clientCertAccount(context.Background(), ClientCertConfig{AllowIdentityAsAccount: true})
+	require.False(t, ok, "no client certificate")
+}
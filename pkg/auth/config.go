@@ -3,8 +3,77 @@ package auth
 import "time"
 
 type Config struct {
-	AccessTokenLifespan  time.Duration `yaml:"accessTokenLifespan"`
-	RefreshTokenLifespan time.Duration `yaml:"refreshTokenLifespan"`
-	ClientID             string        `yaml:"clientID"`
-	Issuer               string        `yaml:"issuer"`
+	AccessTokenLifespan  time.Duration    `yaml:"accessTokenLifespan"`
+	RefreshTokenLifespan time.Duration    `yaml:"refreshTokenLifespan"`
+	ClientID             string           `yaml:"clientID"`
+	Issuer               string           `yaml:"issuer"`
+	KeyRotationPeriod    time.Duration    `yaml:"keyRotationPeriod"`
+	OIDC                 OIDCConfig       `yaml:"oidc"`
+	LDAP                 LDAPConfig       `yaml:"ldap"`
+	ClientCert           ClientCertConfig `yaml:"clientCert"`
+	// Base URL of endpoints in OpenID discovery metadata, e.g: https://ceph-api.example.com.
+	// If not set, URL is built from request host.
+	PublicURL string `yaml:"publicURL"`
+	// IP addresses or CIDRs of reverse proxies allowed to override request host
+	// with X-Forwarded-Proto and X-Forwarded-Host headers. Ignored if PublicURL is set.
+	TrustedProxies []string `yaml:"trustedProxies"`
+}
+
+// OIDCConfig - external OpenID Connect identity provider config.
+// Federated users log in with ID token issued by the provider instead of password.
+type OIDCConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Provider issuer URL. Used to discover provider JWKS and to validate "iss" claim.
+	IssuerURL string `yaml:"issuerURL"`
+	// Expected "aud" claim of ID token.
+	ClientID      string `yaml:"clientID"`
+	UsernameClaim string `yaml:"usernameClaim"`
+	GroupsClaim   string `yaml:"groupsClaim"`
+	// Maps provider groups to ceph-api roles.
+	RoleMapping []GroupRoles `yaml:"roleMapping"`
+}
+
+type GroupRoles struct {
+	Group string   `yaml:"group"`
+	Roles []string `yaml:"roles"`
+}
+
+// LDAPConfig - LDAP/AD authentication backend config.
+// Users are authenticated with LDAP bind and provisioned to accessdb on login with roles mapped from their groups.
+type LDAPConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// ldap:// or ldaps:// server URL
+	URL                string `yaml:"url"`
+	StartTLS           bool   `yaml:"startTLS"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
+	// Service account to search users. Anonymous search is used if not set.
+	BindDN       string `yaml:"bindDN"`
+	BindPassword string `yaml:"bindPassword"`
+	UserBaseDN   string `yaml:"userBaseDN"`
+	// Search filter for user entry. {username} is replaced with escaped username.
+	UserFilter     string `yaml:"userFilter"`
+	NameAttribute  string `yaml:"nameAttribute"`
+	EmailAttribute string `yaml:"emailAttribute"`
+	// User entry attribute with group DNs.
+	GroupAttribute string `yaml:"groupAttribute"`
+	// Maps group DN or CN to ceph-api roles.
+	RoleMapping []GroupRoles `yaml:"roleMapping"`
+}
+
+// ClientCertConfig - gRPC client certificate authentication config.
+// Requires api.secure and api.clientCAFile. Clients with verified certificate can call API without bearer token.
+type ClientCertConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Certificate field used as identity: cn, dns, email or uri. Subject common name is used by default.
+	IdentityFrom string `yaml:"identityFrom"`
+	// Maps certificate identity to ceph-api user or service account.
+	Mapping []CertIdentity `yaml:"mapping"`
+	// Use not mapped identity as account name. Disabled by default, because any certificate issued by client CA
+	// with matching name, e.g. CN=admin, would authenticate as existing user.
+	AllowIdentityAsAccount bool `yaml:"allowIdentityAsAccount"`
+}
+
+type CertIdentity struct {
+	Identity string `yaml:"identity"`
+	Account  string `yaml:"account"`
 }
@@ -19,7 +19,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func() *rsa.PublicKey) grpc_auth.AuthFunc {
+func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func(ctx context.Context, kid string) (*rsa.PublicKey, error), certConf ClientCertConfig) grpc_auth.AuthFunc {
 	return func(ctx context.Context) (context.Context, error) {
 		method, ok := grpc.Method(ctx)
 		if !ok {
@@ -32,10 +32,23 @@ func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func
 		}
 
 		tokenStr, err := grpc_auth.AuthFromMD(ctx, "bearer")
+		if err != nil && certConf.Enabled {
+			if account, ok := clientCertAccount(ctx, certConf); ok {
+				return clientCertAuth(ctx, userSvc, account)
+			}
+		}
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to extract bearer token from grpc meta")
 			return nil, unauthenticated(fmt.Errorf("no token present: %w", types.ErrUnauthenticated))
 		}
+		if user.IsAPIKey(tokenStr) {
+			account, roles, err := userSvc.AuthenticateAPIKey(ctx, tokenStr)
+			if err != nil {
+				zerolog.Ctx(ctx).Err(err).Msg("unable to authenticate api key")
+				return nil, unauthenticated(types.ErrUnauthenticated)
+			}
+			return withPermissions(ctx, userSvc, account, roles), nil
+		}
 		_, ar, err := provider.IntrospectToken(ctx, tokenStr, fosite.AccessToken, new(fosite.DefaultSession))
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to introspect token")
@@ -44,7 +57,8 @@ func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func
 		username := ar.GetSession().GetSubject()
 		claims := jwt.MapClaims{}
 		token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-			return getKey(), nil
+			kid, _ := token.Header["kid"].(string)
+			return getKey(ctx, kid)
 		})
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Msg("unable to parse jwt")
@@ -59,16 +73,43 @@ func AuthFunc(userSvc *user.Service, provider fosite.OAuth2Provider, getKey func
 			return nil, unauthenticated(fmt.Errorf("unable to validate jwt claims: %w", types.ErrUnauthenticated))
 		}
 
+		if sso, _ := claims[ssoClaim].(bool); sso {
+			// federated user is not stored in accessdb, use roles from token
+			return withPermissions(ctx, userSvc, username, claimStrings(claims[rolesClaim])), nil
+		}
+
 		usr, err := userSvc.GetUser(ctx, username)
 		if err != nil {
 			zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("account not found")
 			return nil, unauthenticated(types.ErrUnauthenticated)
 		}
-		ctx = log.WithUsername(ctx, usr.Username)
-		ctx = xctx.SetPermissions(ctx, userSvc.GetPermissions(ctx, username))
+		return withPermissions(ctx, userSvc, usr.Username, usr.Roles), nil
+	}
+}
 
-		return ctx, nil
+// clientCertAuth sets permissions of user or service account mapped from verified client certificate.
+func clientCertAuth(ctx context.Context, userSvc *user.Service, account string) (context.Context, error) {
+	if usr, err := userSvc.GetUser(ctx, account); err == nil {
+		if !usr.Enabled {
+			zerolog.Ctx(ctx).Error().Str("username", account).Msg("client certificate user disabled")
+			return nil, unauthenticated(types.ErrUnauthenticated)
+		}
+		return withPermissions(ctx, userSvc, usr.Username, usr.Roles), nil
+	}
+	sa, err := userSvc.GetServiceAccount(ctx, account)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", account).Msg("client certificate account not found")
+		return nil, unauthenticated(types.ErrUnauthenticated)
 	}
+	return withPermissions(ctx, userSvc, sa.Name, sa.Roles), nil
+}
+
+// withPermissions sets username, roles and permissions granted by roles to request context.
+func withPermissions(ctx context.Context, userSvc *user.Service, username string, roles []string) context.Context {
+	ctx = log.WithUsername(ctx, username)
+	ctx = xctx.SetRoles(ctx, roles)
+	ctx = xctx.SetPermissions(ctx, userSvc.GetRolesPermissions(ctx, roles))
+	return xctx.SetResourcePermissions(ctx, userSvc.GetRolesResourcePermissions(ctx, roles))
 }
 
 func unauthenticated(err error) error {
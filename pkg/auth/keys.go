@@ -0,0 +1,326 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/rs/zerolog"
+)
+
+const (
+	keysKey = "mgr/ceph-api/oauth_keys"
+	// min interval between key reloads caused by tokens signed with unknown key
+	keysReloadInterval = 10 * time.Second
+)
+
+var (
+	setKeysMonCmd = rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": keysKey}}
+	getKeysMonCmd = rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": keysKey}}
+)
+
+// signingKey is a RSA key used to sign JWT tokens and HMAC secret used to sign opaque tokens (e.g. refresh tokens).
+type signingKey struct {
+	ID         string    `json:"kid"`
+	Created    time.Time `json:"created"`
+	PrivateKey string    `json:"private_key"`
+	Secret     []byte    `json:"secret"`
+
+	rsaKey *rsa.PrivateKey
+}
+
+type keysDB struct {
+	Keys []signingKey `json:"keys"`
+}
+
+// keyStore keeps OAuth signing keys in config-key store, so tokens stay valid across restarts and replicas.
+// The newest key is used for signing. Previous keys are used only for verification
+// until all tokens signed by them are expired.
+type keyStore struct {
+	sync.RWMutex
+	radosSvc       *rados.Svc
+	rotationPeriod time.Duration
+	// how long tokens signed by rotated key can be valid
+	retention  time.Duration
+	keys       []signingKey
+	lastReload time.Time
+}
+
+func newKeyStore(ctx context.Context, radosSvc *rados.Svc, config Config) (*keyStore, error) {
+	res := &keyStore{
+		radosSvc:       radosSvc,
+		rotationPeriod: config.KeyRotationPeriod,
+		retention:      max(config.AccessTokenLifespan, config.RefreshTokenLifespan),
+	}
+	if err := res.load(ctx); err != nil {
+		return nil, err
+	}
+	if len(res.keys) == 0 || res.rotationDue() {
+		if err := res.rotate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// load reads keys from config-key store. Caller must hold write lock.
+func (s *keyStore) load(ctx context.Context) error {
+	s.lastReload = time.Now()
+	cmdRes, err := s.radosSvc.ExecMon(ctx, getKeysMonCmd)
+	if err != nil {
+		if errors.Is(err, types.RadosErrorNotFound) {
+			s.keys = nil
+			return nil
+		}
+		return err
+	}
+	var res keysDB
+	if err = json.Unmarshal(cmdRes, &res); err != nil {
+		return fmt.Errorf("%w: unable to parse oauth keys", err)
+	}
+	keys := make([]signingKey, 0, len(res.Keys))
+	for _, k := range res.Keys {
+		block, _ := pem.Decode([]byte(k.PrivateKey))
+		if block == nil {
+			return fmt.Errorf("%w: unable to decode oauth key %q", types.ErrInternal, k.ID)
+		}
+		k.rsaKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("%w: unable to parse oauth key %q", err, k.ID)
+		}
+		keys = append(keys, k)
+	}
+	s.keys = keys
+	s.sortKeys()
+	return nil
+}
+
+// sortKeys sorts keys from newest to oldest.
+func (s *keyStore) sortKeys() {
+	sort.Slice(s.keys, func(i, j int) bool {
+		return s.keys[i].Created.After(s.keys[j].Created)
+	})
+}
+
+func (s *keyStore) store(ctx context.Context) error {
+	res, err := json.Marshal(&keysDB{Keys: s.keys})
+	if err != nil {
+		return err
+	}
+	_, err = s.radosSvc.ExecMonWithInputBuff(ctx, setKeysMonCmd, res)
+	return err
+}
+
+func (s *keyStore) rotationDue() bool {
+	return s.rotationPeriod > 0 && time.Since(s.keys[0].Created) > s.rotationPeriod
+}
+
+// rotate creates new signing key and removes keys which cannot have valid tokens anymore.
+// Caller must hold write lock.
+func (s *keyStore) rotate(ctx context.Context) error {
+	// reload keys to not overwrite keys rotated by other replica
+	current := s.keys
+	if err := s.load(ctx); err != nil {
+		return err
+	}
+	for _, k := range current {
+		if _, ok := s.find(k.ID); !ok {
+			s.keys = append(s.keys, k)
+		}
+	}
+	s.sortKeys()
+	if len(s.keys) != 0 && !s.rotationDue() {
+		return nil
+	}
+	key, err := newSigningKey()
+	if err != nil {
+		return err
+	}
+	keys := []signingKey{key}
+	// key was used for signing until the next key was created
+	supersededAt := key.Created
+	for _, k := range s.keys {
+		if time.Since(supersededAt) < s.retention {
+			keys = append(keys, k)
+		}
+		supersededAt = k.Created
+	}
+	s.keys = keys
+	if err = s.store(ctx); err != nil {
+		return err
+	}
+	zerolog.Ctx(ctx).Info().Str("kid", key.ID).Int("keys", len(keys)).Msg("oauth signing key rotated")
+	return nil
+}
+
+func newSigningKey() (signingKey, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return signingKey{}, err
+	}
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return signingKey{}, err
+	}
+	id := make([]byte, 8)
+	if _, err = rand.Read(id); err != nil {
+		return signingKey{}, err
+	}
+	return signingKey{
+		ID:      hex.EncodeToString(id),
+		Created: time.Now().UTC(),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		})),
+		Secret: secret,
+		rsaKey: privateKey,
+	}, nil
+}
+
+// active returns key for signing new tokens. Rotates key if rotation period is passed.
+func (s *keyStore) active(ctx context.Context) signingKey {
+	s.RLock()
+	if !s.rotationDue() {
+		defer s.RUnlock()
+		return s.keys[0]
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+	if s.rotationDue() {
+		if err := s.rotate(ctx); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to rotate oauth signing key")
+		}
+	}
+	return s.keys[0]
+}
+
+// get returns key by id. Reloads keys from config-key store if key is unknown,
+// because it could be created by other replica.
+func (s *keyStore) get(ctx context.Context, kid string) (signingKey, bool) {
+	s.RLock()
+	key, ok := s.find(kid)
+	reload := !ok && time.Since(s.lastReload) > keysReloadInterval
+	s.RUnlock()
+	if !reload {
+		return key, ok
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if key, ok = s.find(kid); ok || time.Since(s.lastReload) <= keysReloadInterval {
+		return key, ok
+	}
+	keys := s.keys
+	if err := s.load(ctx); err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to reload oauth signing keys")
+		s.keys = keys
+		return signingKey{}, false
+	}
+	if len(s.keys) == 0 {
+		s.keys = keys
+	}
+	return s.find(kid)
+}
+
+func (s *keyStore) find(kid string) (signingKey, bool) {
+	for _, k := range s.keys {
+		if k.ID == kid {
+			return k, true
+		}
+	}
+	return signingKey{}, false
+}
+
+// all returns all keys which can be used to verify tokens, starting from active key.
+func (s *keyStore) all(ctx context.Context) []signingKey {
+	s.active(ctx)
+	s.RLock()
+	defer s.RUnlock()
+	return append([]signingKey(nil), s.keys...)
+}
+
+// secrets returns HMAC secret of active key and secrets of rotated keys.
+func (s *keyStore) secrets(ctx context.Context) ([]byte, [][]byte) {
+	active := s.active(ctx)
+	s.RLock()
+	defer s.RUnlock()
+	rotated := make([][]byte, 0, len(s.keys))
+	for _, k := range s.keys {
+		if k.ID != active.ID {
+			rotated = append(rotated, k.Secret)
+		}
+	}
+	return active.Secret, rotated
+}
+
+// keySigner signs JWT with active key and sets its id to "kid" header.
+// Tokens are verified with the key referenced by "kid" header.
+type keySigner struct {
+	jwt.DefaultSigner
+	keys *keyStore
+}
+
+var _ jwt.Signer = &keySigner{}
+
+func (s *keySigner) Generate(ctx context.Context, claims jwt.MapClaims, header jwt.Mapper) (string, string, error) {
+	key := s.keys.active(ctx)
+	signer := &jwt.DefaultSigner{GetPrivateKey: func(context.Context) (interface{}, error) {
+		return key.rsaKey, nil
+	}}
+	if header == nil {
+		return signer.Generate(ctx, claims, header)
+	}
+	headers := header.ToMap()
+	headers["kid"] = key.ID
+	return signer.Generate(ctx, claims, &jwt.Headers{Extra: headers})
+}
+
+func (s *keySigner) Validate(ctx context.Context, token string) (string, error) {
+	if _, err := s.Decode(ctx, token); err != nil {
+		return "", err
+	}
+	return s.GetSignature(ctx, token)
+}
+
+func (s *keySigner) Decode(ctx context.Context, token string) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(token, jwt.MapClaims{}, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := s.keys.get(ctx, kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return &key.rsaKey.PublicKey, nil
+	})
+}
+
+// secretsConfig provides HMAC secrets from key store.
+type secretsConfig struct {
+	*fosite.Config
+	keys *keyStore
+}
+
+func (c *secretsConfig) GetGlobalSecret(ctx context.Context) ([]byte, error) {
+	secret, _ := c.keys.secrets(ctx)
+	return secret, nil
+}
+
+func (c *secretsConfig) GetRotatedGlobalSecrets(ctx context.Context) ([][]byte, error) {
+	_, rotated := c.keys.secrets(ctx)
+	return rotated, nil
+}
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/rs/zerolog"
+)
+
+const (
+	ldapTimeout             = 10 * time.Second
+	ldapUsernamePlaceholder = "{username}"
+)
+
+// ldapAuthenticator verifies credentials with LDAP bind and provisions user to accessdb
+// with roles mapped from LDAP groups.
+type ldapAuthenticator struct {
+	conf    LDAPConfig
+	userSvc *user.Service
+}
+
+func newLDAPAuthenticator(conf LDAPConfig, userSvc *user.Service) (*ldapAuthenticator, error) {
+	if conf.URL == "" {
+		return nil, fmt.Errorf("%w: ldap url is required", types.ErrInvalidConfig)
+	}
+	if conf.UserBaseDN == "" {
+		return nil, fmt.Errorf("%w: ldap userBaseDN is required", types.ErrInvalidConfig)
+	}
+	if conf.UserFilter == "" {
+		conf.UserFilter = "(uid=" + ldapUsernamePlaceholder + ")"
+	}
+	if !strings.Contains(conf.UserFilter, ldapUsernamePlaceholder) {
+		return nil, fmt.Errorf("%w: ldap userFilter must contain %s", types.ErrInvalidConfig, ldapUsernamePlaceholder)
+	}
+	if conf.NameAttribute == "" {
+		conf.NameAttribute = "cn"
+	}
+	if conf.EmailAttribute == "" {
+		conf.EmailAttribute = "mail"
+	}
+	if conf.GroupAttribute == "" {
+		conf.GroupAttribute = "memberOf"
+	}
+	return &ldapAuthenticator{conf: conf, userSvc: userSvc}, nil
+}
+
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, username, password string) error {
+	// empty password results in unauthenticated bind which always succeeds
+	if username == "" || password == "" {
+		return fmt.Errorf("%w: ldap username and password required", types.ErrUnauthenticated)
+	}
+	conn, err := a.dial()
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to connect to ldap server")
+		return fmt.Errorf("%w: unable to connect to ldap server", types.ErrUnauthenticated)
+	}
+	defer conn.Close()
+
+	if a.conf.BindDN != "" {
+		if err = conn.Bind(a.conf.BindDN, a.conf.BindPassword); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to bind ldap service account")
+			return fmt.Errorf("%w: unable to bind ldap service account", types.ErrUnauthenticated)
+		}
+	}
+	entry, err := a.findUser(conn, username)
+	if err != nil {
+		return err
+	}
+	if err = conn.Bind(entry.DN, password); err != nil {
+		return fmt.Errorf("%w: invalid ldap credentials", types.ErrUnauthenticated)
+	}
+
+	roles := a.mapRoles(entry.GetAttributeValues(a.conf.GroupAttribute))
+	if len(roles) == 0 {
+		return fmt.Errorf("%w: no ceph-api roles mapped to ldap groups of user %q", types.ErrAccessDenied, username)
+	}
+	usr := user.User{
+		Username: username,
+		Roles:    roles,
+		Enabled:  true,
+		Source:   user.SourceLDAP,
+	}
+	if name := entry.GetAttributeValue(a.conf.NameAttribute); name != "" {
+		usr.Name = &name
+	}
+	if email := entry.GetAttributeValue(a.conf.EmailAttribute); email != "" {
+		usr.Email = &email
+	}
+	usr, err = a.userSvc.ProvisionExternalUser(ctx, usr)
+	if err != nil {
+		return err
+	}
+	if !usr.Enabled {
+		return fmt.Errorf("%w: user disabled", types.ErrUnauthenticated)
+	}
+	return nil
+}
+
+func (a *ldapAuthenticator) dial() (*ldap.Conn, error) {
+	opts := []ldap.DialOpt{ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout})}
+	tlsConf := &tls.Config{InsecureSkipVerify: a.conf.InsecureSkipVerify}
+	if strings.HasPrefix(a.conf.URL, "ldaps://") {
+		opts = append(opts, ldap.DialWithTLSConfig(tlsConf))
+	}
+	conn, err := ldap.DialURL(a.conf.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(ldapTimeout)
+	if a.conf.StartTLS {
+		if err = conn.StartTLS(tlsConf); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (a *ldapAuthenticator) findUser(conn *ldap.Conn, username string) (*ldap.Entry, error) {
+	filter := strings.ReplaceAll(a.conf.UserFilter, ldapUsernamePlaceholder, ldap.EscapeFilter(username))
+	res, err := conn.Search(ldap.NewSearchRequest(
+		a.conf.UserBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout.Seconds()), false,
+		filter,
+		[]string{a.conf.NameAttribute, a.conf.EmailAttribute, a.conf.GroupAttribute},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, fmt.Errorf("%w: ldap user %q not found", types.ErrNotFound, username)
+		}
+		return nil, fmt.Errorf("%w: ldap user search failed: %v", types.ErrUnauthenticated, err)
+	}
+	if res == nil || len(res.Entries) == 0 {
+		return nil, fmt.Errorf("%w: ldap user %q not found", types.ErrNotFound, username)
+	}
+	if len(res.Entries) > 1 {
+		return nil, fmt.Errorf("%w: ldap user filter matches multiple entries for %q", types.ErrUnauthenticated, username)
+	}
+	return res.Entries[0], nil
+}
+
+// mapRoles returns roles mapped to groups. Group from mapping matches either group DN or its CN.
+func (a *ldapAuthenticator) mapRoles(groups []string) []string {
+	var roles []string
+	for _, m := range a.conf.RoleMapping {
+		for _, g := range groups {
+			if strings.EqualFold(m.Group, g) || strings.EqualFold(m.Group, groupCN(g)) {
+				roles = append(roles, m.Roles...)
+				break
+			}
+		}
+	}
+	sort.Strings(roles)
+	return slices.Compact(roles)
+}
+
+func groupCN(groupDN string) string {
+	dn, err := ldap.ParseDN(groupDN)
+	if err != nil || len(dn.RDNs) == 0 {
+		return ""
+	}
+	for _, attr := range dn.RDNs[0].Attributes {
+		if strings.EqualFold(attr.Type, "cn") {
+			return attr.Value
+		}
+	}
+	return ""
+}
@@ -12,15 +12,19 @@ import (
 	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/ory/fosite"
 )
 
-func (s *Server) Login(ctx context.Context, username, password string) (*LoginResp, error) {
+func (s *Server) Login(ctx context.Context, username, password, otp string) (*LoginResp, error) {
 	v := url.Values{
 		"grant_type": {"password"},
 		"username":   {username},
 		"password":   {password},
 		"client_id":  {s.clientID},
 	}
+	if otp != "" {
+		v.Set(otpParam, otp)
+	}
 	req, err := http.NewRequest("POST", "http://localhost:80/api/auth", strings.NewReader(v.Encode()))
 	if err != nil {
 		return nil, err
@@ -29,24 +33,74 @@ func (s *Server) Login(ctx context.Context, username, password string) (*LoginRe
 	req.SetBasicAuth(s.clientID, "")
 	resp := httptest.NewRecorder()
 	s.TokenEndpoint(resp, req)
-	if resp.Code < 200 || resp.Code > 299 {
-		return nil, types.ErrUnauthenticated
-	}
 	resBody := struct {
-		Token string `json:"access_token"`
+		Token            string `json:"access_token"`
+		ErrorDescription string `json:"error_description"`
 	}{}
 	json.Unmarshal(resp.Body.Bytes(), &resBody)
+	if resp.Code < 200 || resp.Code > 299 {
+		if strings.Contains(resBody.ErrorDescription, mfaRequiredHint) {
+			return nil, fmt.Errorf("%w: %w", types.ErrUnauthenticated, user.ErrMFARequired)
+		}
+		return nil, types.ErrUnauthenticated
+	}
 	if resBody.Token == "" {
 		return nil, fmt.Errorf("%w: unable to get token from auth resp boyd", types.ErrInternal)
 	}
+	if s.oidc.isIDToken(password) {
+		identity, err := s.oidc.verify(ctx, password)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResp{
+			Token:       resBody.Token,
+			User:        user.User{Username: identity.Username, Roles: identity.Roles, Enabled: true},
+			Permissions: s.userSvc.GetRolesPermissions(ctx, identity.Roles),
+			SSO:         true,
+		}, nil
+	}
 	usr, err := s.userSvc.GetUser(ctx, username)
 	if err != nil {
 		return nil, err
 	}
 	return &LoginResp{
-		Token:       resBody.Token,
-		User:        usr,
-		Permissions: s.userSvc.GetPermissions(ctx, username),
+		Token:                resBody.Token,
+		User:                 usr,
+		Permissions:          s.userSvc.GetPermissions(ctx, username),
+		PwdExpirationWarning: s.userSvc.PwdExpirationWarning(usr),
+	}, nil
+}
+
+// Check returns user and permissions of valid access token. Revoked or expired tokens are rejected.
+func (s *Server) Check(ctx context.Context, token string) (*LoginResp, error) {
+	_, ar, err := s.provider.IntrospectToken(ctx, token, fosite.AccessToken, new(fosite.DefaultSession))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid token: %v", types.ErrUnauthenticated, err)
+	}
+	t, err := s.signer.Decode(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to parse token: %v", types.ErrUnauthenticated, err)
+	}
+	username := ar.GetSession().GetSubject()
+	if sso, _ := t.Claims[ssoClaim].(bool); sso {
+		// federated user is not stored in accessdb, use roles from token
+		roles := claimStrings(t.Claims[rolesClaim])
+		return &LoginResp{
+			Token:       token,
+			User:        user.User{Username: username, Roles: roles, Enabled: true},
+			Permissions: s.userSvc.GetRolesPermissions(ctx, roles),
+			SSO:         true,
+		}, nil
+	}
+	usr, err := s.userSvc.GetUser(ctx, username)
+	if err != nil || !usr.Enabled {
+		return nil, fmt.Errorf("%w: account not found or disabled", types.ErrUnauthenticated)
+	}
+	return &LoginResp{
+		Token:                token,
+		User:                 usr,
+		Permissions:          s.userSvc.GetPermissions(ctx, username),
+		PwdExpirationWarning: s.userSvc.PwdExpirationWarning(usr),
 	}, nil
 }
 
@@ -54,6 +108,10 @@ type LoginResp struct {
 	Token       string
 	User        user.User
 	Permissions map[string][]string
+	// SSO is true for users authenticated by external OIDC provider
+	SSO bool
+	// PwdExpirationWarning is user.PwdExpirationWarning* level if password expires soon
+	PwdExpirationWarning int
 }
 
 func (s *Server) Logout(ctx context.Context) error {
@@ -2,12 +2,13 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/handler/oauth2"
 	"github.com/rs/zerolog"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func (s *Server) AuthEndpoint(rw http.ResponseWriter, req *http.Request) {
@@ -41,6 +42,15 @@ func (s *Server) authorize(ctx context.Context, ar fosite.AuthorizeRequester, rw
 	log := zerolog.Ctx(ctx)
 	session := s.newSession(username, nil)
 
+	// Verify password the same way as password grant: local users are subject to lockout and password expiration,
+	// LDAP users are provisioned on login.
+	err := s.storage.Authenticate(ctx, username, password)
+	if err != nil {
+		log.Error().Err(err).Msg("could not validate password")
+		http.Error(rw, "can't find account", http.StatusForbidden)
+		return
+	}
+
 	// Set subject to the session
 	usr, err := s.userSvc.GetUser(ctx, username)
 	if err != nil {
@@ -52,12 +62,17 @@ func (s *Server) authorize(ctx context.Context, ar fosite.AuthorizeRequester, rw
 		http.Error(rw, "access denied", http.StatusForbidden)
 		return
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(usr.Password), []byte(password))
+	err = s.userSvc.VerifyMFA(ctx, usr.Username, ar.GetRequestForm().Get(otpParam))
 	if err != nil {
-		log.Error().Err(err).Msg("could not validate password")
-		http.Error(rw, "can't find account", http.StatusForbidden)
+		log.Info().Err(err).Str("username", usr.Username).Msg("second factor verification failed")
+		if errors.Is(err, user.ErrMFARequired) {
+			http.Error(rw, mfaRequiredHint, http.StatusForbidden)
+			return
+		}
+		http.Error(rw, "invalid second factor code", http.StatusForbidden)
 		return
 	}
+	s.userSvc.ResetFailedLogins(ctx, usr.Username)
 
 	// grant requested scopes
 	for _, scope := range ar.GetRequestedScopes() {
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/types"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/rs/zerolog"
+)
+
+const (
+	TokenPath         = "/api/oauth/token"
+	AuthPath          = "/api/oauth/auth"
+	RevokePath        = "/api/oauth/revoke"
+	IntrospectPath    = "/api/oauth/introspect"
+	DiscoveryPath     = "/.well-known/openid-configuration"
+	JWKSPath          = "/.well-known/jwks.json"
+	signingAlgorithm  = "RS256"
+	jwkUseSignature   = "sig"
+	subjectTypePublic = "public"
+	// client is public and has no secret
+	tokenEndpointAuthNone = "none"
+)
+
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type discoveryResp struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// DiscoveryEndpoint serves OpenID provider metadata.
+// Endpoint URLs are built from configured public URL. Otherwise, request host is used,
+// so metadata is valid for any address ceph-api is reachable by.
+func (s *Server) DiscoveryEndpoint(rw http.ResponseWriter, req *http.Request) {
+	baseURL := s.baseURL(req)
+	client := s.client
+	writeJSON(rw, req, &discoveryResp{
+		Issuer:                            s.issuer,
+		AuthorizationEndpoint:             baseURL + AuthPath,
+		TokenEndpoint:                     baseURL + TokenPath,
+		IntrospectionEndpoint:             baseURL + IntrospectPath,
+		RevocationEndpoint:                baseURL + RevokePath,
+		JWKSURI:                           baseURL + JWKSPath,
+		ScopesSupported:                   client.GetScopes(),
+		ResponseTypesSupported:            client.GetResponseTypes(),
+		GrantTypesSupported:               client.GetGrantTypes(),
+		SubjectTypesSupported:             []string{subjectTypePublic},
+		IDTokenSigningAlgValuesSupported:  []string{signingAlgorithm},
+		TokenEndpointAuthMethodsSupported: []string{tokenEndpointAuthNone},
+	})
+}
+
+// JWKSEndpoint serves public keys to verify tokens issued by ceph-api.
+// Contains active key and rotated keys which still can have valid tokens.
+func (s *Server) JWKSEndpoint(rw http.ResponseWriter, req *http.Request) {
+	keys := s.keys.all(req.Context())
+	res := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(keys))}
+	for _, k := range keys {
+		res.Keys = append(res.Keys, jose.JSONWebKey{
+			Key:       &k.rsaKey.PublicKey,
+			KeyID:     k.ID,
+			Algorithm: signingAlgorithm,
+			Use:       jwkUseSignature,
+		})
+	}
+	writeJSON(rw, req, &res)
+}
+
+// baseURL returns configured public URL or URL of request host.
+// Forwarded headers are honoured only if request came from trusted proxy,
+// because otherwise any client could point discovery metadata to its own endpoints.
+func (s *Server) baseURL(req *http.Request) string {
+	if s.publicURL != "" {
+		return s.publicURL
+	}
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	host := req.Host
+	if !s.fromTrustedProxy(req) {
+		return scheme + "://" + host
+	}
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	if fwdHost := req.Header.Get("X-Forwarded-Host"); fwdHost != "" {
+		host = fwdHost
+	}
+	return scheme + "://" + host
+}
+
+func (s *Server) fromTrustedProxy(req *http.Request) bool {
+	if len(s.trustedProxies) == 0 {
+		return false
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range s.trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePublicURL validates public URL and removes trailing slash.
+func parsePublicURL(publicURL string) (string, error) {
+	if publicURL == "" {
+		return "", nil
+	}
+	u, err := url.Parse(publicURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%w: auth publicURL %q must be absolute http or https URL", types.ErrInvalidConfig, publicURL)
+	}
+	return strings.TrimSuffix(publicURL, "/"), nil
+}
+
+// parseTrustedProxies parses IP addresses and CIDRs of trusted proxies.
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	res := make([]netip.Prefix, 0, len(proxies))
+	for _, p := range proxies {
+		if addr, err := netip.ParseAddr(p); err == nil {
+			res = append(res, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid auth trustedProxies entry %q", types.ErrInvalidConfig, p)
+		}
+		res = append(res, prefix.Masked())
+	}
+	return res, nil
+}
+
+func writeJSON(rw http.ResponseWriter, req *http.Request, v any) {
+	rw.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		zerolog.Ctx(req.Context()).Err(err).Msg("unable to write response")
+	}
+}
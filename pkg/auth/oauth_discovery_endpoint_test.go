@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_baseURL(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8", "::1"})
+	require.NoError(t, err)
+	tests := []struct {
+		name       string
+		publicURL  string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{name: "request host", remoteAddr: "192.168.1.5:4321", want: "http://ceph-api:9969"},
+		{name: "public url", publicURL: "https://api.example.com", remoteAddr: "10.0.0.1:4321", headers: map[string]string{"X-Forwarded-Host": "evil.example.com"}, want: "https://api.example.com"},
+		{name: "untrusted proxy", remoteAddr: "192.168.1.5:4321", headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com"}, want: "http://ceph-api:9969"},
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:4321", headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "api.example.com"}, want: "https://api.example.com"},
+		{name: "trusted ipv6 proxy", remoteAddr: "[::1]:4321", headers: map[string]string{"X-Forwarded-Host": "api.example.com"}, want: "http://api.example.com"},
+		{name: "invalid forwarded proto", remoteAddr: "10.1.2.3:4321", headers: map[string]string{"X-Forwarded-Proto": "javascript"}, want: "http://ceph-api:9969"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{publicURL: tt.publicURL, trustedProxies: proxies}
+			req := httptest.NewRequest("GET", "http://ceph-api:9969"+DiscoveryPath, nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			require.Equal(t, tt.want, s.baseURL(req))
+		})
+	}
+}
+
+func TestParsePublicURL(t *testing.T) {
+	r := require.New(t)
+	res, err := parsePublicURL("https://api.example.com/")
+	r.NoError(err)
+	r.Equal("https://api.example.com", res)
+	res, err = parsePublicURL("")
+	r.NoError(err)
+	r.Empty(res)
+	_, err = parsePublicURL("api.example.com")
+	r.ErrorIs(err, types.ErrInvalidConfig)
+	_, err = parseTrustedProxies([]string{"10.0.0.0/33"})
+	r.ErrorIs(err, types.ErrInvalidConfig)
+}
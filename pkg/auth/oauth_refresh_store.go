@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/handler/oauth2"
+	"github.com/rs/zerolog"
+)
+
+// config-key prefix of refresh token sessions
+const refreshKeyPrefix = "mgr/ceph-api/oauth_refresh/"
+
+// refreshTokenRecord is refresh token session stored in config-key.
+// Request form is not stored, because it contains user password.
+type refreshTokenRecord struct {
+	Active            bool            `json:"active"`
+	RequestID         string          `json:"request_id"`
+	RequestedAt       time.Time       `json:"requested_at"`
+	ClientID          string          `json:"client_id"`
+	RequestedScopes   []string        `json:"requested_scopes"`
+	GrantedScopes     []string        `json:"granted_scopes"`
+	RequestedAudience []string        `json:"requested_audience"`
+	GrantedAudience   []string        `json:"granted_audience"`
+	Session           json.RawMessage `json:"session"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+}
+
+// refreshTokenStore keeps refresh token sessions in config-key store, one key per token.
+// Unlike fosite memory store, sessions survive restarts and are shared by all replicas.
+type refreshTokenStore struct {
+	radosSvc *rados.Svc
+	clients  fosite.ClientManager
+}
+
+func (s *refreshTokenStore) create(ctx context.Context, signature string, req fosite.Requester) error {
+	if err := validateSignature(signature); err != nil {
+		return err
+	}
+	session, err := json.Marshal(req.GetSession())
+	if err != nil {
+		return err
+	}
+	rec := refreshTokenRecord{
+		Active:            true,
+		RequestID:         req.GetID(),
+		RequestedAt:       req.GetRequestedAt(),
+		ClientID:          req.GetClient().GetID(),
+		RequestedScopes:   req.GetRequestedScopes(),
+		GrantedScopes:     req.GetGrantedScopes(),
+		RequestedAudience: req.GetRequestedAudience(),
+		GrantedAudience:   req.GetGrantedAudience(),
+		Session:           session,
+		ExpiresAt:         req.GetSession().GetExpiresAt(fosite.RefreshToken),
+	}
+	return s.put(ctx, signature, rec)
+}
+
+// get returns refresh token session. Returns fosite.ErrInactiveToken along with session if token was revoked or rotated.
+func (s *refreshTokenStore) get(ctx context.Context, signature string, session fosite.Session) (fosite.Requester, error) {
+	if validateSignature(signature) != nil {
+		return nil, fosite.ErrNotFound
+	}
+	res, err := s.radosSvc.ExecMon(ctx, keyCmd("config-key get", refreshKeyPrefix+signature))
+	if errors.Is(err, types.RadosErrorNotFound) {
+		return nil, fosite.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rec refreshTokenRecord
+	if err = json.Unmarshal(res, &rec); err != nil {
+		return nil, fmt.Errorf("%w: unable to decode refresh token session", err)
+	}
+	// record without request id is not a refresh token session
+	if rec.RequestID == "" {
+		return nil, fosite.ErrNotFound
+	}
+	client, err := s.clients.GetClient(ctx, rec.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		session = &oauth2.JWTSession{}
+	}
+	if err = json.Unmarshal(rec.Session, session); err != nil {
+		return nil, fmt.Errorf("%w: unable to decode refresh token session", err)
+	}
+	req := &fosite.Request{
+		ID:                rec.RequestID,
+		RequestedAt:       rec.RequestedAt,
+		Client:            client,
+		RequestedScope:    rec.RequestedScopes,
+		GrantedScope:      rec.GrantedScopes,
+		RequestedAudience: rec.RequestedAudience,
+		GrantedAudience:   rec.GrantedAudience,
+		Form:              url.Values{},
+		Session:           session,
+	}
+	if !rec.Active {
+		return req, fosite.ErrInactiveToken
+	}
+	return req, nil
+}
+
+func (s *refreshTokenStore) remove(ctx context.Context, signature string) error {
+	if validateSignature(signature) != nil {
+		return nil
+	}
+	_, err := s.radosSvc.ExecMon(ctx, keyCmd("config-key rm", refreshKeyPrefix+signature))
+	return err
+}
+
+// revoke deactivates refresh tokens issued for given request. Revoked tokens are kept until they expire
+// to detect refresh token reuse. Expired tokens are removed.
+func (s *refreshTokenStore) revoke(ctx context.Context, requestID string) error {
+	res, err := s.radosSvc.ExecMon(ctx, keyCmd("config-key dump", refreshKeyPrefix))
+	if err != nil {
+		return err
+	}
+	var dump map[string]string
+	if err = json.Unmarshal(res, &dump); err != nil {
+		return fmt.Errorf("%w: unable to decode config-key dump", err)
+	}
+	now := time.Now()
+	for key, val := range dump {
+		signature, ok := strings.CutPrefix(key, refreshKeyPrefix)
+		if !ok {
+			continue
+		}
+		var rec refreshTokenRecord
+		if err = json.Unmarshal([]byte(val), &rec); err != nil {
+			zerolog.Ctx(ctx).Err(err).Str("key", key).Msg("unable to decode refresh token session")
+			continue
+		}
+		switch {
+		case !rec.ExpiresAt.IsZero() && rec.ExpiresAt.Before(now):
+			if err = s.remove(ctx, signature); err != nil {
+				zerolog.Ctx(ctx).Err(err).Str("key", key).Msg("unable to remove expired refresh token session")
+			}
+		case rec.RequestID == requestID && rec.Active:
+			rec.Active = false
+			if err = s.put(ctx, signature, rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *refreshTokenStore) put(ctx context.Context, signature string, rec refreshTokenRecord) error {
+	val, err := json.Marshal(&rec)
+	if err != nil {
+		return err
+	}
+	_, err = s.radosSvc.ExecMonWithInputBuff(ctx, keyCmd("config-key set", refreshKeyPrefix+signature), val)
+	return err
+}
+
+// validateSignature checks that token signature is safe to use in config-key name.
+// Signatures of HMAC tokens are base64url encoded.
+func validateSignature(signature string) error {
+	if signature == "" {
+		return fmt.Errorf("%w: empty token signature", types.ErrInvalidArg)
+	}
+	for _, c := range signature {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return fmt.Errorf("%w: invalid token signature", types.ErrInvalidArg)
+		}
+	}
+	return nil
+}
+
+func keyCmd(prefix, key string) rados.MonCmd {
+	return rados.MonCmd{Prefix: prefix, Args: map[string]any{"key": key}}
+}
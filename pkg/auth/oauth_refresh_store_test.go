@@ -0,0 +1,85 @@
+//go:build mock
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenResp struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+func requestToken(t *testing.T, s *Server, form url.Values) (int, tokenResp) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	s.TokenEndpoint(rec, req)
+	var res tokenResp
+	_ = json.NewDecoder(rec.Body).Decode(&res)
+	return rec.Code, res
+}
+
+func TestServer_RefreshAfterRestart(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+	userSvc, err := user.New(radosSvc, user.Config{BcryptPwdCost: 4, AccessDBLockPool: ".mgr"})
+	r.NoError(err)
+	r.NoError(userSvc.CreateUser(ctx, user.User{Username: "refresh-user", Password: "Refresh-pass-1", Roles: []string{"read-only"}, Enabled: true}))
+	conf := Config{AccessTokenLifespan: time.Minute, RefreshTokenLifespan: time.Hour, ClientID: "ceph-api", Issuer: "ceph-api"}
+
+	s1, err := NewServer(ctx, conf, userSvc, radosSvc)
+	r.NoError(err)
+	code, tokens := requestToken(t, s1, url.Values{
+		"grant_type": {"password"},
+		"client_id":  {conf.ClientID},
+		"username":   {"refresh-user"},
+		"password":   {"Refresh-pass-1"},
+	})
+	r.Equal(http.StatusOK, code)
+	r.NotEmpty(tokens.RefreshToken)
+
+	// refresh token issued before restart is accepted by new server instance
+	s2, err := NewServer(ctx, conf, userSvc, radosSvc)
+	r.NoError(err)
+	refreshForm := func(token string) url.Values {
+		return url.Values{"grant_type": {"refresh_token"}, "client_id": {conf.ClientID}, "refresh_token": {token}}
+	}
+	code, refreshed := requestToken(t, s2, refreshForm(tokens.RefreshToken))
+	r.Equal(http.StatusOK, code)
+	r.NotEmpty(refreshed.AccessToken)
+	r.NotEmpty(refreshed.RefreshToken)
+	r.NotEqual(tokens.RefreshToken, refreshed.RefreshToken)
+	resp, err := s2.Check(ctx, refreshed.AccessToken)
+	r.NoError(err)
+	r.Equal("refresh-user", resp.User.Username)
+
+	// rotated refresh token can't be reused on other instance
+	code, _ = requestToken(t, s1, refreshForm(tokens.RefreshToken))
+	r.Equal(http.StatusUnauthorized, code)
+	// reuse revokes refresh tokens issued after rotation
+	code, _ = requestToken(t, s1, refreshForm(refreshed.RefreshToken))
+	r.Equal(http.StatusUnauthorized, code)
+
+	// password is not persisted with refresh token session
+	res, err := radosSvc.ExecMon(ctx, keyCmd("config-key dump", refreshKeyPrefix))
+	r.NoError(err)
+	r.NotContains(string(res), "Refresh-pass-1")
+}
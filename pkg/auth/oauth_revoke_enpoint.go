@@ -1,7 +1,32 @@
 package auth
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/ory/fosite"
+	"github.com/ory/fosite/compose"
+	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/token/jwt"
+	"github.com/rs/zerolog"
+)
+
+const (
+	revokedKey = "mgr/ceph-api/oauth_revoked"
+	// min interval between reloads of tokens revoked by other replicas
+	revokedReloadInterval = 10 * time.Second
+)
+
+var (
+	setRevokedMonCmd = rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": revokedKey}}
+	getRevokedMonCmd = rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": revokedKey}}
 )
 
 func (s *Server) RevokeEndpoint(rw http.ResponseWriter, req *http.Request) {
@@ -10,7 +35,147 @@ func (s *Server) RevokeEndpoint(rw http.ResponseWriter, req *http.Request) {
 
 	// This will accept the token revocation request and validate various parameters.
 	err := s.provider.NewRevocationRequest(ctx, req)
+	if err == nil {
+		// Access tokens are validated statelessly, so revoked JWT has to be rejected explicitly.
+		if err = s.revokeJWT(ctx, req.PostForm.Get("token")); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to store revoked token")
+			// fosite responds with 200 to all errors except invalid request and client
+			rw.Header().Set("Cache-Control", "no-store")
+			rw.Header().Set("Pragma", "no-cache")
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			writeJSON(rw, req, fosite.ErrTemporarilyUnavailable)
+			return
+		}
+	}
 
 	// All done, send the response.
 	s.provider.WriteRevocationResponse(ctx, rw, err)
 }
+
+func (s *Server) revokeJWT(ctx context.Context, token string) error {
+	t, err := s.signer.Decode(ctx, token)
+	if err != nil {
+		// not a JWT or already expired
+		return nil
+	}
+	sig, err := s.signer.GetSignature(ctx, token)
+	if err != nil {
+		return nil
+	}
+	claims := jwt.JWTClaims{}
+	claims.FromMapClaims(t.Claims)
+	return s.revoked.add(ctx, sig, claims.ExpiresAt)
+}
+
+type revokedDB struct {
+	// expiration time by token signature
+	Tokens map[string]time.Time `json:"tokens"`
+}
+
+// revokedTokens holds signatures of revoked JWT until they expire.
+// Revoked tokens are kept in config-key store next to signing keys, so revocation survives restarts
+// and is applied by all replicas. Tokens revoked by other replica are reloaded periodically.
+type revokedTokens struct {
+	sync.RWMutex
+	radosSvc   *rados.Svc
+	tokens     map[string]time.Time
+	lastReload time.Time
+}
+
+func newRevokedTokens(ctx context.Context, radosSvc *rados.Svc) (*revokedTokens, error) {
+	res := &revokedTokens{radosSvc: radosSvc}
+	if err := res.load(ctx); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// load reads revoked tokens from config-key store. State is not changed on error. Caller must hold write lock.
+func (r *revokedTokens) load(ctx context.Context) error {
+	r.lastReload = time.Now()
+	cmdRes, err := r.radosSvc.ExecMon(ctx, getRevokedMonCmd)
+	if err != nil {
+		if errors.Is(err, types.RadosErrorNotFound) {
+			r.tokens = map[string]time.Time{}
+			return nil
+		}
+		return err
+	}
+	var res revokedDB
+	if err = json.Unmarshal(cmdRes, &res); err != nil {
+		return fmt.Errorf("%w: unable to parse revoked oauth tokens", err)
+	}
+	r.tokens = res.Tokens
+	if r.tokens == nil {
+		r.tokens = map[string]time.Time{}
+	}
+	return nil
+}
+
+// add stores signature of revoked token along with its expiration time. Expired tokens are removed.
+func (r *revokedTokens) add(ctx context.Context, sig string, expiresAt time.Time) error {
+	r.Lock()
+	defer r.Unlock()
+	// reload tokens to not overwrite tokens revoked by other replica
+	if err := r.load(ctx); err != nil {
+		return err
+	}
+	now := time.Now()
+	for s, exp := range r.tokens {
+		if exp.Before(now) {
+			delete(r.tokens, s)
+		}
+	}
+	r.tokens[sig] = expiresAt
+	res, err := json.Marshal(&revokedDB{Tokens: r.tokens})
+	if err != nil {
+		return err
+	}
+	_, err = r.radosSvc.ExecMonWithInputBuff(ctx, setRevokedMonCmd, res)
+	return err
+}
+
+// contains returns true if token was revoked. Reloads revoked tokens if reload interval is passed,
+// because token could be revoked by other replica.
+func (r *revokedTokens) contains(ctx context.Context, sig string) bool {
+	r.RLock()
+	_, ok := r.tokens[sig]
+	reload := !ok && time.Since(r.lastReload) > revokedReloadInterval
+	r.RUnlock()
+	if !reload {
+		return ok
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if time.Since(r.lastReload) > revokedReloadInterval {
+		if err := r.load(ctx); err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to reload revoked oauth tokens")
+		}
+	}
+	_, ok = r.tokens[sig]
+	return ok
+}
+
+// revocableJWTValidator validates access tokens only by signature and claims,
+// so tokens stay valid after restart and can be validated by any replica.
+type revocableJWTValidator struct {
+	*oauth2.StatelessJWTValidator
+	revoked *revokedTokens
+}
+
+func (v *revocableJWTValidator) IntrospectToken(ctx context.Context, token string, tokenUse fosite.TokenUse, accessRequest fosite.AccessRequester, scopes []string) (fosite.TokenUse, error) {
+	if sig, err := v.GetSignature(ctx, token); err == nil && v.revoked.contains(ctx, sig) {
+		return "", fosite.ErrInactiveToken.WithHint("Token was revoked.")
+	}
+	return v.StatelessJWTValidator.IntrospectToken(ctx, token, tokenUse, accessRequest, scopes)
+}
+
+func revocableJWTIntrospectionFactory(revoked *revokedTokens) compose.Factory {
+	return func(config fosite.Configurator, storage interface{}, strategy interface{}) interface{} {
+		return &revocableJWTValidator{
+			StatelessJWTValidator: compose.OAuth2StatelessJWTIntrospectionFactory(config, storage, strategy).(*oauth2.StatelessJWTValidator),
+			revoked:               revoked,
+		}
+	}
+}
@@ -0,0 +1,46 @@
+//go:build mock
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedTokens_SharedBetweenReplicas(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+
+	r1, err := newRevokedTokens(ctx, radosSvc)
+	r.NoError(err)
+	r2, err := newRevokedTokens(ctx, radosSvc)
+	r.NoError(err)
+
+	r.NoError(r1.add(ctx, "sig-1", time.Now().Add(time.Hour)))
+	r.NoError(r1.add(ctx, "sig-expired", time.Now().Add(-time.Second)))
+	r.True(r1.contains(ctx, "sig-1"))
+	r.False(r1.contains(ctx, "sig-2"))
+
+	// other replica reloads revoked tokens after reload interval
+	r.False(r2.contains(ctx, "sig-1"))
+	r2.lastReload = time.Now().Add(-revokedReloadInterval - time.Second)
+	r.True(r2.contains(ctx, "sig-1"))
+
+	// token revoked by other replica is not overwritten
+	r.NoError(r2.add(ctx, "sig-2", time.Now().Add(time.Hour)))
+
+	// revoked tokens survive restart, expired tokens are removed
+	r3, err := newRevokedTokens(ctx, radosSvc)
+	r.NoError(err)
+	r.True(r3.contains(ctx, "sig-1"))
+	r.True(r3.contains(ctx, "sig-2"))
+	r.NotContains(r3.tokens, "sig-expired")
+}
@@ -2,65 +2,96 @@ package auth
 
 import (
 	"context"
-	"crypto/rand"
 	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/netip"
 	"time"
 
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/compose"
 	"github.com/ory/fosite/handler/oauth2"
+	"github.com/ory/fosite/handler/openid"
 	"github.com/ory/fosite/storage"
 	"github.com/ory/fosite/token/jwt"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Server struct {
-	publicKey            *rsa.PublicKey
-	keyID                string
+	keys                 *keyStore
+	signer               *keySigner
+	revoked              *revokedTokens
 	issuer               string
+	publicURL            string
+	trustedProxies       []netip.Prefix
 	clientID             string
+	client               *fosite.DefaultClient
 	refreshTokenLifespan time.Duration
 
 	provider fosite.OAuth2Provider
-	storage  fosite.Storage
+	storage  *fositeStore
 
 	authorizeCodeStrategy oauth2.AuthorizeCodeStrategy
 	refreshTokenStrategy  oauth2.RefreshTokenStrategy
 	accessTokenStrategy   oauth2.AccessTokenStrategy
 
 	userSvc *user.Service
+	oidc    *oidcProvider
 }
 
-func NewServer(config Config, userSvc *user.Service) (*Server, error) {
-	var secret = make([]byte, 32)
-	_, err := rand.Read(secret)
+func NewServer(ctx context.Context, config Config, userSvc *user.Service, radosSvc *rados.Svc) (*Server, error) {
+	keys, err := newKeyStore(ctx, radosSvc, config)
 	if err != nil {
 		return nil, err
 	}
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	publicURL, err := parsePublicURL(config.PublicURL)
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies, err := parseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := newRevokedTokens(ctx, radosSvc)
+	if err != nil {
+		return nil, err
+	}
+	oidc, err := newOIDCProvider(config.OIDC)
 	if err != nil {
 		return nil, err
 	}
+	authenticators := []Authenticator{&localAuthenticator{userSvc: userSvc}}
+	if config.LDAP.Enabled {
+		ldapAuth, err := newLDAPAuthenticator(config.LDAP, userSvc)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, ldapAuth)
+	}
 
+	client := &fosite.DefaultClient{
+		ID:            config.ClientID,
+		Public:        true,
+		ResponseTypes: []string{"id_token", "code", "token", "id_token token", "code id_token", "code token", "code id_token token"},
+		GrantTypes:    []string{"refresh_token", "password"},
+		Scopes:        []string{"openid", "offline"},
+	}
 	defaultStor := storage.NewMemoryStore()
 	defaultStor.Clients = map[string]fosite.Client{
-		config.ClientID: &fosite.DefaultClient{
-			ID:            config.ClientID,
-			Public:        true,
-			ResponseTypes: []string{"id_token", "code", "token", "id_token token", "code id_token", "code token", "code id_token token"},
-			GrantTypes:    []string{"refresh_token", "password"},
-			Scopes:        []string{"openid", "offline"},
-		}}
+		config.ClientID: client,
+	}
 
 	storage := &fositeStore{
-		userSvc:     userSvc,
-		MemoryStore: defaultStor,
+		userSvc:        userSvc,
+		oidc:           oidc,
+		authenticators: authenticators,
+		refreshTokens:  &refreshTokenStore{radosSvc: radosSvc, clients: defaultStor},
+		MemoryStore:    defaultStor,
 	}
 
 	conf := &fosite.Config{
-		GlobalSecret:  secret,
 		ScopeStrategy: fosite.HierarchicScopeStrategy,
 		// Allow all grants to get refresh token
 		RefreshTokenScopes:   []string{},
@@ -68,12 +99,16 @@ func NewServer(config Config, userSvc *user.Service) (*Server, error) {
 		RefreshTokenLifespan: config.RefreshTokenLifespan,
 	}
 
-	pGetter := func(ctx context.Context) (any, error) { return privateKey, nil }
+	signer := &keySigner{keys: keys}
 	strategy := &compose.CommonStrategy{
 		// Override default strategy to issue JWT instead of HMAC tokens
-		CoreStrategy:               compose.NewOAuth2JWTStrategy(pGetter, compose.NewOAuth2HMACStrategy(conf), conf),
-		OpenIDConnectTokenStrategy: compose.NewOpenIDConnectStrategy(pGetter, conf),
-		Signer:                     &jwt.DefaultSigner{GetPrivateKey: pGetter}}
+		CoreStrategy: &oauth2.DefaultJWTStrategy{
+			Signer:          signer,
+			HMACSHAStrategy: compose.NewOAuth2HMACStrategy(&secretsConfig{Config: conf, keys: keys}),
+			Config:          conf,
+		},
+		OpenIDConnectTokenStrategy: &openid.DefaultStrategy{Signer: signer, Config: conf},
+		Signer:                     signer}
 
 	oauth2Provider := compose.Compose(conf, storage, strategy,
 		compose.OAuth2AuthorizeExplicitFactory,
@@ -82,15 +117,19 @@ func NewServer(config Config, userSvc *user.Service) (*Server, error) {
 		compose.OAuth2RefreshTokenGrantFactory,
 		compose.OAuth2ResourceOwnerPasswordCredentialsFactory,
 		compose.RFC7523AssertionGrantFactory,
-		compose.OAuth2TokenIntrospectionFactory,
+		revocableJWTIntrospectionFactory(revoked),
 		compose.OAuth2TokenRevocationFactory,
 	)
 
 	return &Server{
-		publicKey:             &privateKey.PublicKey,
-		keyID:                 "0",
+		keys:                  keys,
+		signer:                signer,
+		revoked:               revoked,
 		issuer:                config.Issuer,
+		publicURL:             publicURL,
+		trustedProxies:        trustedProxies,
 		clientID:              config.ClientID,
+		client:                client,
 		refreshTokenLifespan:  config.RefreshTokenLifespan,
 		provider:              oauth2Provider,
 		storage:               storage,
@@ -98,6 +137,7 @@ func NewServer(config Config, userSvc *user.Service) (*Server, error) {
 		refreshTokenStrategy:  strategy,
 		accessTokenStrategy:   strategy,
 		userSvc:               userSvc,
+		oidc:                  oidc,
 	}, nil
 }
 
@@ -105,13 +145,18 @@ func (s *Server) Provider() fosite.OAuth2Provider {
 	return s.provider
 }
 
-func (s *Server) GetPublicKey() *rsa.PublicKey {
-	return s.publicKey
+// GetPublicKey returns public key of token signing key with given id.
+func (s *Server) GetPublicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	key, ok := s.keys.get(ctx, kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown signing key %q", types.ErrUnauthenticated, kid)
+	}
+	return &key.rsaKey.PublicKey, nil
 }
 
 func (s *Server) newSession(subject string, claims map[string]interface{}) oauth2.JWTSessionContainer {
+	// "kid" header is set by signer
 	jwtHeaders := make(map[string]interface{})
-	jwtHeaders["kid"] = s.keyID
 	return &oauth2.JWTSession{
 		JWTClaims: &jwt.JWTClaims{
 			Issuer:    s.issuer,
@@ -126,23 +171,56 @@ func (s *Server) newSession(subject string, claims map[string]interface{}) oauth
 	}
 }
 
+// fositeStore keeps clients and authorize codes in memory. Refresh token sessions are persisted,
+// so refresh tokens stay valid after restart and on other replicas.
 type fositeStore struct {
-	userSvc *user.Service
+	userSvc        *user.Service
+	oidc           *oidcProvider
+	authenticators []Authenticator
+	refreshTokens  *refreshTokenStore
 	*storage.MemoryStore
 }
 
-func (s *fositeStore) Authenticate(ctx context.Context, name string, secret string) error {
+func (s *fositeStore) CreateRefreshTokenSession(ctx context.Context, signature string, req fosite.Requester) error {
+	return s.refreshTokens.create(ctx, signature, req)
+}
 
-	usr, err := s.userSvc.GetUser(ctx, name)
-	if err != nil {
-		return fosite.ErrNotFound
-	}
-	if !usr.Enabled {
-		return fosite.ErrNotFound.WithDebug("User disabled")
+func (s *fositeStore) GetRefreshTokenSession(ctx context.Context, signature string, session fosite.Session) (fosite.Requester, error) {
+	return s.refreshTokens.get(ctx, signature, session)
+}
+
+func (s *fositeStore) DeleteRefreshTokenSession(ctx context.Context, signature string) error {
+	return s.refreshTokens.remove(ctx, signature)
+}
+
+func (s *fositeStore) RevokeRefreshToken(ctx context.Context, requestID string) error {
+	return s.refreshTokens.revoke(ctx, requestID)
+}
+
+func (s *fositeStore) RevokeRefreshTokenMaybeGracePeriod(ctx context.Context, requestID string, _ string) error {
+	return s.refreshTokens.revoke(ctx, requestID)
+}
+
+func (s *fositeStore) Authenticate(ctx context.Context, name string, secret string) error {
+	// federated users pass ID token of external OIDC provider as password
+	if s.oidc.isIDToken(secret) {
+		identity, err := s.oidc.verify(ctx, secret)
+		if err != nil {
+			return fosite.ErrNotFound.WithDebug(err.Error())
+		}
+		if identity.Username != name {
+			return fosite.ErrNotFound.WithDebug("ID token username mismatch")
+		}
+		return nil
 	}
-	err = bcrypt.CompareHashAndPassword([]byte(usr.Password), []byte(secret))
-	if err != nil {
-		return fosite.ErrNotFound.WithDebug("Invalid Credentials")
+
+	var errs []error
+	for _, a := range s.authenticators {
+		err := a.Authenticate(ctx, name, secret)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
 	}
-	return nil
+	return fosite.ErrNotFound.WithDebug(errors.Join(errs...).Error())
 }
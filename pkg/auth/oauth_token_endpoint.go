@@ -1,14 +1,22 @@
 package auth
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/ory/fosite"
 	"github.com/ory/fosite/handler/oauth2"
 	"github.com/rs/zerolog"
 )
 
+const (
+	// otpParam is password grant form parameter with TOTP or recovery code of user with enabled MFA.
+	otpParam        = "otp"
+	mfaRequiredHint = "Second factor is required. Provide TOTP or recovery code in 'otp' parameter."
+)
+
 func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	logger := zerolog.Ctx(ctx)
@@ -18,6 +26,12 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 	// For an example of storage implementation that takes advantage of that, see SQL Store (fosite_store_sql.go) from ory/Hydra project.
 	sessionData := s.newSession("", nil)
 
+	// ID token of federated user is passed as password. Read it before fosite removes password from the form.
+	var idToken string
+	if req.PostFormValue("grant_type") == "password" && s.oidc.isIDToken(req.PostFormValue("password")) {
+		idToken = req.PostFormValue("password")
+	}
+
 	// Next we create a response for the access request. Again, we iterate through the TokenEndpointHandlers
 	// and aggregate the result in response.
 	accessRequest, err := s.provider.NewAccessRequest(ctx, req, sessionData)
@@ -34,29 +48,59 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	username := sessionData.GetUsername()
-	if username == "" {
-		username = accessRequest.GetRequestForm().Get("username")
-	}
+	session := accessRequest.GetSession().(*oauth2.JWTSession)
+	sso, _ := session.JWTClaims.Extra[ssoClaim].(bool)
+	switch {
+	case idToken != "":
+		identity, err := s.oidc.verify(ctx, idToken)
+		if err != nil {
+			fositeError := fosite.ErrorToRFC6749Error(err)
+			logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("invalid id token")
+			s.provider.WriteAccessError(ctx, rw, accessRequest, fosite.ErrInvalidGrant.WithWrap(err))
+			return
+		}
+		// Federated user is not stored in accessdb, so roles are passed in token claims
+		session.JWTClaims.Subject = identity.Username
+		session.Subject = identity.Username
+		session.JWTClaims.Add(ssoClaim, true)
+		session.JWTClaims.Add(rolesClaim, identity.Roles)
+	case sso:
+		// Refresh token of federated user. Subject and roles are restored from the original session.
+	default:
+		// refresh token session is restored with subject of the original session
+		username := session.GetSubject()
+		if username == "" {
+			username = accessRequest.GetRequestForm().Get("username")
+		}
 
-	usr, err := s.userSvc.GetUser(ctx, username)
-	if err != nil {
-		fositeError := fosite.ErrorToRFC6749Error(err)
-		logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("can't find account")
-		s.provider.WriteAccessError(ctx, rw, accessRequest, err)
-		return
-	}
-	if !usr.Enabled {
-		err = fmt.Errorf("inactive account")
-		fositeError := fosite.ErrorToRFC6749Error(err)
-		logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("account is inactive")
-		s.provider.WriteAccessError(ctx, rw, accessRequest, err)
-		return
+		usr, err := s.userSvc.GetUser(ctx, username)
+		if err != nil {
+			fositeError := fosite.ErrorToRFC6749Error(err)
+			logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("can't find account")
+			s.provider.WriteAccessError(ctx, rw, accessRequest, err)
+			return
+		}
+		if !usr.Enabled {
+			err = fmt.Errorf("inactive account")
+			fositeError := fosite.ErrorToRFC6749Error(err)
+			logger.Error().Str("error_description", fositeError.GetDescription()).Err(err).Msg("account is inactive")
+			s.provider.WriteAccessError(ctx, rw, accessRequest, err)
+			return
+		}
+		// Password was verified by fosite. Check second factor if user has MFA enabled.
+		if accessRequest.GetGrantTypes().ExactOne("password") {
+			err = s.userSvc.VerifyMFA(ctx, usr.Username, accessRequest.GetRequestForm().Get(otpParam))
+			if err != nil {
+				logger.Info().Err(err).Str("username", usr.Username).Msg("second factor verification failed")
+				s.provider.WriteAccessError(ctx, rw, accessRequest, mfaError(err))
+				return
+			}
+			s.userSvc.ResetFailedLogins(ctx, usr.Username)
+		}
+		// Set token subject as login
+		session.JWTClaims.Subject = usr.Username
+		session.Subject = usr.Username
 	}
-	session := accessRequest.GetSession().(*oauth2.JWTSession)
-	// Set token subject as login
-	session.JWTClaims.Subject = usr.Username
-	session.Subject = usr.Username
 
 	// Next we create a response for the access request. Again, we iterate through the TokenEndpointHandlers
 	// and aggregate the result in response.
@@ -74,3 +118,10 @@ func (s *Server) TokenEndpoint(rw http.ResponseWriter, req *http.Request) {
 	// All done, send the response.
 	s.provider.WriteAccessResponse(ctx, rw, accessRequest, response)
 }
+
+func mfaError(err error) error {
+	if errors.Is(err, user.ErrMFARequired) {
+		return fosite.ErrInvalidGrant.WithHint(mfaRequiredHint).WithWrap(err)
+	}
+	return fosite.ErrInvalidGrant.WithHint("Invalid second factor code.").WithWrap(err)
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// ceph-api token claims of federated users
+	ssoClaim   = "sso"
+	rolesClaim = "roles"
+
+	oidcHTTPTimeout = 10 * time.Second
+	// min interval between provider JWKS fetches caused by ID tokens signed with unknown key
+	oidcJWKSRefetchInterval = 10 * time.Second
+)
+
+// federatedIdentity is a user authenticated by external OIDC provider.
+type federatedIdentity struct {
+	Username string
+	Roles    []string
+}
+
+// oidcProvider verifies ID tokens issued by external OIDC provider.
+type oidcProvider struct {
+	sync.Mutex
+	conf      OIDCConfig
+	client    *http.Client
+	jwks      jose.JSONWebKeySet
+	lastFetch time.Time
+}
+
+func newOIDCProvider(conf OIDCConfig) (*oidcProvider, error) {
+	if !conf.Enabled {
+		return nil, nil
+	}
+	if conf.IssuerURL == "" {
+		return nil, fmt.Errorf("%w: oidc issuerURL is required", types.ErrInvalidConfig)
+	}
+	if conf.ClientID == "" {
+		return nil, fmt.Errorf("%w: oidc clientID is required", types.ErrInvalidConfig)
+	}
+	if conf.UsernameClaim == "" {
+		conf.UsernameClaim = "preferred_username"
+	}
+	if conf.GroupsClaim == "" {
+		conf.GroupsClaim = "groups"
+	}
+	return &oidcProvider{
+		conf:   conf,
+		client: &http.Client{Timeout: oidcHTTPTimeout},
+	}, nil
+}
+
+// isIDToken reports whether token is a JWT issued by the provider. Signature is not verified.
+func (p *oidcProvider) isIDToken(token string) bool {
+	if p == nil || strings.Count(token, ".") != 2 {
+		return false
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return false
+	}
+	return claims.VerifyIssuer(p.conf.IssuerURL, true)
+}
+
+// verify validates ID token signature and claims and maps provider groups to ceph-api roles.
+func (p *oidcProvider) verify(ctx context.Context, idToken string) (federatedIdentity, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil {
+		return federatedIdentity{}, fmt.Errorf("%w: invalid id token: %v", types.ErrUnauthenticated, err)
+	}
+	if !token.Valid {
+		return federatedIdentity{}, fmt.Errorf("%w: invalid id token", types.ErrUnauthenticated)
+	}
+	if !claims.VerifyIssuer(p.conf.IssuerURL, true) {
+		return federatedIdentity{}, fmt.Errorf("%w: invalid id token issuer", types.ErrUnauthenticated)
+	}
+	if !claims.VerifyAudience(p.conf.ClientID, true) {
+		return federatedIdentity{}, fmt.Errorf("%w: invalid id token audience", types.ErrUnauthenticated)
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return federatedIdentity{}, fmt.Errorf("%w: id token expired", types.ErrUnauthenticated)
+	}
+	username, _ := claims[p.conf.UsernameClaim].(string)
+	if username == "" {
+		return federatedIdentity{}, fmt.Errorf("%w: id token has no %q claim", types.ErrUnauthenticated, p.conf.UsernameClaim)
+	}
+	roles := p.mapRoles(claimStrings(claims[p.conf.GroupsClaim]))
+	if len(roles) == 0 {
+		return federatedIdentity{}, fmt.Errorf("%w: no ceph-api roles mapped to groups of user %q", types.ErrAccessDenied, username)
+	}
+	return federatedIdentity{Username: username, Roles: roles}, nil
+}
+
+func (p *oidcProvider) mapRoles(groups []string) []string {
+	var roles []string
+	for _, m := range p.conf.RoleMapping {
+		if slices.Contains(groups, m.Group) {
+			roles = append(roles, m.Roles...)
+		}
+	}
+	sort.Strings(roles)
+	return slices.Compact(roles)
+}
+
+// key returns provider public key by id. Provider JWKS is fetched on first use and when key is unknown.
+func (p *oidcProvider) key(ctx context.Context, kid string) (interface{}, error) {
+	p.Lock()
+	defer p.Unlock()
+	if keys := p.jwks.Key(kid); len(keys) != 0 {
+		return keys[0].Key, nil
+	}
+	if time.Since(p.lastFetch) < oidcJWKSRefetchInterval {
+		return nil, fmt.Errorf("unknown id token signing key %q", kid)
+	}
+	p.lastFetch = time.Now()
+	jwks, err := p.fetchJWKS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.jwks = jwks
+	if keys := p.jwks.Key(kid); len(keys) != 0 {
+		return keys[0].Key, nil
+	}
+	return nil, fmt.Errorf("unknown id token signing key %q", kid)
+}
+
+func (p *oidcProvider) fetchJWKS(ctx context.Context) (jose.JSONWebKeySet, error) {
+	discovery := struct {
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.conf.IssuerURL, "/")+DiscoveryPath, &discovery); err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("%w: unable to get oidc provider configuration", err)
+	}
+	var jwks jose.JSONWebKeySet
+	if err := p.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("%w: unable to get oidc provider jwks", err)
+	}
+	return jwks, nil
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// claimStrings converts string or list claim to string slice.
+func claimStrings(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		res := make([]string, 0, len(v))
+		for _, s := range v {
+			if str, ok := s.(string); ok {
+				res = append(res, str)
+			}
+		}
+		return res
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package cephcmd
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/rs/zerolog"
+)
+
+// mgr command descriptions, output of "ceph tell mgr get_command_descriptions"
+//
+//go:embed get_command_descriptions_dump.json
+var descriptionsFile embed.FS
+
+// Command description flags, see MonCommand::FLAG_* in ceph sources.
+const (
+	// command is no longer supported
+	FlagObsolete = 1 << 1
+	// command is handled by mgr
+	FlagMgr = 1 << 3
+)
+
+// Arg is a command argument description.
+type Arg struct {
+	Name string `json:"name"`
+	// ceph argument type, e.g: CephString, CephInt, CephChoices
+	Type string `json:"type"`
+	// allowed values of CephChoices separated by "|"
+	Strings string `json:"strings,omitempty"`
+	// allowed characters of CephString as regexp character class, e.g: [A-Za-z0-9-_.]
+	GoodChars string `json:"goodchars,omitempty"`
+	// min and optional max of CephInt and CephFloat separated by "|"
+	Range string `json:"range,omitempty"`
+	// argument accepts list of values
+	List     bool `json:"list,omitempty"`
+	Required bool `json:"required"`
+}
+
+// UnmarshalJSON parses argument description. Older ceph releases encode "req" and "n" as strings.
+func (a *Arg) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name      string `json:"name"`
+		Type      string `json:"type"`
+		Strings   string `json:"strings"`
+		GoodChars string `json:"goodchars"`
+		Range     string `json:"range"`
+		N         any    `json:"n"`
+		Req       any    `json:"req"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*a = Arg{
+		Name:      raw.Name,
+		Type:      raw.Type,
+		Strings:   raw.Strings,
+		GoodChars: raw.GoodChars,
+		Range:     raw.Range,
+		List:      raw.N == "N",
+		Required:  raw.Req == nil || raw.Req == true || raw.Req == "true",
+	}
+	return nil
+}
+
+// Command is a ceph command signature.
+type Command struct {
+	// literal words of signature, e.g: osd pool ls
+	Prefix string `json:"prefix"`
+	Args   []Arg  `json:"args"`
+	Help   string `json:"help"`
+	// ceph auth capability module, e.g: osd, mon, mgr
+	Module string `json:"module"`
+	// required ceph capability: r, w, x or combination
+	Perm  string `json:"perm"`
+	Flags int    `json:"flags"`
+}
+
+// Mgr reports whether command is sent to mgr instead of mon.
+func (c Command) Mgr() bool {
+	return c.Flags&FlagMgr != 0
+}
+
+// Write reports whether command requires ceph write or execute capability.
+func (c Command) Write() bool {
+	return strings.ContainsAny(c.Perm, "wx")
+}
+
+type description struct {
+	Sig    []json.RawMessage `json:"sig"`
+	Help   string            `json:"help"`
+	Module string            `json:"module"`
+	Perm   string            `json:"perm"`
+	Flags  int               `json:"flags"`
+}
+
+// Index holds command signatures by prefix.
+type Index struct {
+	commands map[string][]Command
+	prefixes []string
+}
+
+// New loads embedded mgr command descriptions. Unless skipUpdate is set, descriptions of mon and mgr
+// commands supported by the cluster are requested from mon and take precedence over embedded ones.
+func New(ctx context.Context, radosSvc *rados.Svc, skipUpdate bool) (*Index, error) {
+	data, err := descriptionsFile.ReadFile("get_command_descriptions_dump.json")
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to read embedded command descriptions", err)
+	}
+	// embedded descriptions are obtained from mgr, so all of them are mgr commands
+	base, err := parseDescriptions(data, FlagMgr)
+	if err != nil {
+		return nil, err
+	}
+	if skipUpdate {
+		return newIndex(base), nil
+	}
+	cmdRes, err := radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "get_command_descriptions"})
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("unable to get command descriptions from mon, only embedded mgr commands are available")
+		return newIndex(base), nil
+	}
+	cluster, err := parseDescriptions(cmdRes, 0)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("unable to parse command descriptions from mon, only embedded mgr commands are available")
+		return newIndex(base), nil
+	}
+	res := newIndex(cluster)
+	for prefix, cmds := range newIndex(base).commands {
+		if _, ok := res.commands[prefix]; !ok {
+			res.commands[prefix] = cmds
+			res.prefixes = append(res.prefixes, prefix)
+		}
+	}
+	sort.Strings(res.prefixes)
+	zerolog.Ctx(ctx).Info().Int("total_commands", len(res.prefixes)).Msg("loaded ceph command descriptions from cluster")
+	return res, nil
+}
+
+// parseDescriptions parses output of get_command_descriptions. Extra flags are added to every command.
+func parseDescriptions(data []byte, extraFlags int) ([]Command, error) {
+	var descs map[string]description
+	if err := json.Unmarshal(data, &descs); err != nil {
+		return nil, fmt.Errorf("%w: unable to parse command descriptions", err)
+	}
+	// keys are cmd000, cmd001, ... Keep original order for commands with the same prefix.
+	keys := make([]string, 0, len(descs))
+	for k := range descs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	res := make([]Command, 0, len(descs))
+	for _, k := range keys {
+		d := descs[k]
+		cmd := Command{Help: d.Help, Module: d.Module, Perm: d.Perm, Flags: d.Flags | extraFlags}
+		var words []string
+		for _, rawArg := range d.Sig {
+			var word string
+			if err := json.Unmarshal(rawArg, &word); err == nil {
+				words = append(words, word)
+				continue
+			}
+			var arg Arg
+			if err := json.Unmarshal(rawArg, &arg); err != nil {
+				return nil, fmt.Errorf("%w: unable to parse command %s argument", err, k)
+			}
+			cmd.Args = append(cmd.Args, arg)
+		}
+		cmd.Prefix = strings.Join(words, " ")
+		res = append(res, cmd)
+	}
+	return res, nil
+}
+
+func newIndex(cmds []Command) *Index {
+	res := &Index{commands: map[string][]Command{}}
+	for _, cmd := range cmds {
+		if _, ok := res.commands[cmd.Prefix]; !ok {
+			res.prefixes = append(res.prefixes, cmd.Prefix)
+		}
+		res.commands[cmd.Prefix] = append(res.commands[cmd.Prefix], cmd)
+	}
+	sort.Strings(res.prefixes)
+	return res
+}
+
+// List returns commands with prefix starting with given string, sorted by prefix.
+func (i *Index) List(prefix string) []Command {
+	var res []Command
+	for _, p := range i.prefixes {
+		if strings.HasPrefix(p, prefix) {
+			res = append(res, i.commands[p]...)
+		}
+	}
+	return res
+}
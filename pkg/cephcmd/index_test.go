@@ -0,0 +1,34 @@
+//go:build mock
+
+package cephcmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_Cluster(t *testing.T) {
+	r := require.New(t)
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+
+	idx, err := New(context.Background(), radosSvc, false)
+	r.NoError(err)
+
+	// mon commands are loaded from cluster
+	cmds := idx.List("osd pool create")
+	r.Len(cmds, 1)
+	r.False(cmds[0].Mgr())
+	r.True(cmds[0].Write())
+	// mgr commands reported by cluster are not duplicated by embedded ones
+	r.Len(idx.List("balancer status"), 1)
+	// embedded mgr commands fill the gaps
+	cmds = idx.List("balancer mode")
+	r.Len(cmds, 1)
+	r.True(cmds[0].Mgr())
+}
@@ -0,0 +1,236 @@
+package cephcmd
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+var (
+	pgidRegexp = regexp.MustCompile(`^[0-9]+\.[0-9a-fA-F]+$`)
+	// output formats accepted by every command
+	formats = []string{"json", "json-pretty", "xml", "xml-pretty", "plain"}
+)
+
+// Validate checks arguments against signatures of the command with given prefix.
+// Returns matched signature and command with arguments converted to types expected by ceph.
+// Output format is set to json unless format argument is given.
+func (i *Index) Validate(prefix string, args map[string]any) (Command, rados.MonCmd, error) {
+	cmds, ok := i.commands[prefix]
+	if !ok || prefix == "" {
+		return Command{}, rados.MonCmd{}, fmt.Errorf("%w: unknown command %q", types.ErrInvalidArg, prefix)
+	}
+	var firstErr error
+	for _, cmd := range cmds {
+		if cmd.Flags&FlagObsolete != 0 {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%w: command %q is obsolete", types.ErrInvalidArg, prefix)
+			}
+			continue
+		}
+		res, err := cmd.validate(args)
+		if err == nil {
+			return cmd, res, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return Command{}, rados.MonCmd{}, firstErr
+}
+
+func (c Command) validate(args map[string]any) (rados.MonCmd, error) {
+	res := rados.MonCmd{Prefix: c.Prefix, Format: "json", Args: map[string]any{}}
+	known := map[string]bool{}
+	for _, a := range c.Args {
+		known[a.Name] = true
+		v, ok := args[a.Name]
+		if !ok || v == nil {
+			if a.Required {
+				return rados.MonCmd{}, fmt.Errorf("%w: missing required argument %q of command %q", types.ErrInvalidArg, a.Name, c.Prefix)
+			}
+			continue
+		}
+		converted, err := a.convert(v)
+		if err != nil {
+			return rados.MonCmd{}, fmt.Errorf("%w: invalid argument %q of command %q: %v", types.ErrInvalidArg, a.Name, c.Prefix, err)
+		}
+		if format, ok := converted.(string); ok && a.Name == "format" {
+			// some mgr commands declare format argument in signature
+			res.Format = format
+			continue
+		}
+		res.Args[a.Name] = converted
+	}
+	for name, v := range args {
+		if known[name] || v == nil {
+			continue
+		}
+		if name != "format" {
+			return rados.MonCmd{}, fmt.Errorf("%w: unknown argument %q of command %q", types.ErrInvalidArg, name, c.Prefix)
+		}
+		format, ok := v.(string)
+		if !ok || !contains(formats, format) {
+			return rados.MonCmd{}, fmt.Errorf("%w: invalid format %v, valid values: %v", types.ErrInvalidArg, v, formats)
+		}
+		res.Format = format
+	}
+	return res, nil
+}
+
+// convert checks argument value and converts it to type expected by ceph.
+// Single value is accepted for list argument.
+func (a Arg) convert(v any) (any, error) {
+	if !a.List {
+		return a.convertValue(v)
+	}
+	vals, ok := v.([]any)
+	if !ok {
+		vals = []any{v}
+	}
+	res := make([]any, len(vals))
+	for i, val := range vals {
+		converted, err := a.convertValue(val)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = converted
+	}
+	return res, nil
+}
+
+func (a Arg) convertValue(v any) (any, error) {
+	switch a.Type {
+	case "CephInt":
+		num, err := toInt(v)
+		if err != nil {
+			return nil, err
+		}
+		return num, a.checkRange(float64(num))
+	case "CephFloat":
+		num, err := toFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		return num, a.checkRange(num)
+	case "CephBool":
+		switch val := v.(type) {
+		case bool:
+			return val, nil
+		case string:
+			if b, err := strconv.ParseBool(val); err == nil {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("expected bool")
+	case "CephOsdName":
+		// osd id or name osd.<id>
+		if s, ok := v.(string); ok {
+			v = strings.TrimPrefix(s, "osd.")
+		}
+		id, err := toInt(v)
+		if err != nil || id < 0 {
+			return nil, fmt.Errorf("expected osd id or name, e.g: 1, osd.1")
+		}
+		return id, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected string")
+	}
+	switch a.Type {
+	case "CephChoices":
+		choices := strings.Split(a.Strings, "|")
+		if !contains(choices, s) {
+			return nil, fmt.Errorf("%q is not one of %v", s, choices)
+		}
+	case "CephPgid":
+		if !pgidRegexp.MatchString(s) {
+			return nil, fmt.Errorf("expected pg id, e.g: 1.2f")
+		}
+	case "CephString":
+		if a.GoodChars == "" {
+			break
+		}
+		re, err := regexp.Compile("^" + a.GoodChars + "*$")
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid goodchars %q", err, a.GoodChars)
+		}
+		if !re.MatchString(s) {
+			return nil, fmt.Errorf("%q contains characters not allowed by %s", s, a.GoodChars)
+		}
+	}
+	// other types, e.g: CephPoolname, CephEntityAddr, CephUUID, are checked by ceph
+	return s, nil
+}
+
+// checkRange checks numeric argument against its "min|max" range.
+func (a Arg) checkRange(num float64) error {
+	if a.Range == "" {
+		return nil
+	}
+	bounds := strings.Split(a.Range, "|")
+	if minVal, err := strconv.ParseFloat(bounds[0], 64); err == nil && num < minVal {
+		return fmt.Errorf("%v is less than min %v", num, minVal)
+	}
+	if len(bounds) > 1 {
+		if maxVal, err := strconv.ParseFloat(bounds[1], 64); err == nil && num > maxVal {
+			return fmt.Errorf("%v is greater than max %v", num, maxVal)
+		}
+	}
+	return nil
+}
+
+func toInt(v any) (int64, error) {
+	switch val := v.(type) {
+	case int:
+		return int64(val), nil
+	case int64:
+		return val, nil
+	case float64:
+		// numbers decoded from JSON or protobuf Struct
+		if val != math.Trunc(val) || math.Abs(val) > 1<<53 {
+			return 0, fmt.Errorf("expected integer")
+		}
+		return int64(val), nil
+	case string:
+		num, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected integer")
+		}
+		return num, nil
+	}
+	return 0, fmt.Errorf("expected integer")
+}
+
+func toFloat(v any) (float64, error) {
+	switch val := v.(type) {
+	case int:
+		return float64(val), nil
+	case int64:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	case string:
+		num, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected number")
+		}
+		return num, nil
+	}
+	return 0, fmt.Errorf("expected number")
+}
+
+func contains(list []string, val string) bool {
+	for _, v := range list {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package cephcmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_Embedded(t *testing.T) {
+	r := require.New(t)
+	idx, err := New(context.Background(), nil, true)
+	r.NoError(err)
+
+	cmds := idx.List("balancer mode")
+	r.Len(cmds, 1)
+	cmd := cmds[0]
+	r.True(cmd.Mgr())
+	r.True(cmd.Write())
+	r.Equal("mgr", cmd.Module)
+	r.Len(cmd.Args, 1)
+	r.Equal(Arg{Name: "mode", Type: "CephChoices", Strings: "none|crush-compat|upmap", Required: true}, cmd.Args[0])
+
+	cmds = idx.List("osd safe-to-destroy")
+	r.Len(cmds, 1)
+	r.False(cmds[0].Write())
+	r.True(cmds[0].Args[0].List)
+
+	r.Empty(idx.List("no such command"))
+}
+
+func TestIndex_Validate(t *testing.T) {
+	idx, err := New(context.Background(), nil, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		prefix  string
+		args    map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{name: "unknown command", prefix: "osd frobnicate", wantErr: true},
+		{name: "empty prefix", prefix: "", wantErr: true},
+		{name: "no args", prefix: "balancer status", want: map[string]any{}},
+		{name: "choices", prefix: "balancer mode", args: map[string]any{"mode": "upmap"}, want: map[string]any{"mode": "upmap"}},
+		{name: "invalid choice", prefix: "balancer mode", args: map[string]any{"mode": "fast"}, wantErr: true},
+		{name: "missing required", prefix: "balancer mode", wantErr: true},
+		{name: "unknown argument", prefix: "balancer status", args: map[string]any{"verbose": true}, wantErr: true},
+		{name: "prefix is not argument", prefix: "balancer status", args: map[string]any{"prefix": "osd purge"}, wantErr: true},
+		{name: "format", prefix: "balancer status", args: map[string]any{"format": "plain"}, want: map[string]any{"format": "plain"}},
+		{name: "format in signature", prefix: "crash ls", args: map[string]any{"format": "json-pretty"}, want: map[string]any{"format": "json-pretty"}},
+		{name: "invalid format", prefix: "balancer status", args: map[string]any{"format": "yaml"}, wantErr: true},
+		{name: "osd name", prefix: "osd destroy", args: map[string]any{"id": "osd.3", "yes_i_really_mean_it": true}, want: map[string]any{"id": float64(3), "yes_i_really_mean_it": true}},
+		{name: "osd id", prefix: "osd purge", args: map[string]any{"id": float64(3)}, want: map[string]any{"id": float64(3)}},
+		{name: "invalid osd name", prefix: "osd purge", args: map[string]any{"id": "mon.a"}, wantErr: true},
+		{name: "bool from string", prefix: "osd purge", args: map[string]any{"id": 1, "force": "true"}, want: map[string]any{"id": float64(1), "force": true}},
+		{name: "invalid bool", prefix: "osd purge", args: map[string]any{"id": 1, "force": "maybe"}, wantErr: true},
+		{name: "int and float", prefix: "osd reweight-by-utilization", args: map[string]any{"oload": float64(120), "max_change": "0.05"}, want: map[string]any{"oload": float64(120), "max_change": 0.05}},
+		{name: "fractional int", prefix: "osd reweight-by-utilization", args: map[string]any{"oload": 1.5}, wantErr: true},
+		{name: "list", prefix: "osd safe-to-destroy", args: map[string]any{"ids": []any{"1", "2"}}, want: map[string]any{"ids": []any{"1", "2"}}},
+		{name: "single value for list", prefix: "osd safe-to-destroy", args: map[string]any{"ids": "1"}, want: map[string]any{"ids": []any{"1"}}},
+		{name: "pgid", prefix: "pg repair", args: map[string]any{"pgid": "1.2f"}, want: map[string]any{"pgid": "1.2f"}},
+		{name: "invalid pgid", prefix: "pg repair", args: map[string]any{"pgid": "1"}, wantErr: true},
+		{name: "goodchars", prefix: "fs volume create", args: map[string]any{"name": "vol-1"}, want: map[string]any{"name": "vol-1"}},
+		{name: "bad chars", prefix: "fs volume create", args: map[string]any{"name": "vol 1"}, wantErr: true},
+		{name: "string type", prefix: "pg repair", args: map[string]any{"pgid": 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			cmd, res, err := idx.Validate(tt.prefix, tt.args)
+			if tt.wantErr {
+				r.ErrorIs(err, types.ErrInvalidArg)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.prefix, cmd.Prefix)
+			want := map[string]any{"prefix": tt.prefix, "format": "json"}
+			for k, v := range tt.want {
+				want[k] = v
+			}
+			data, err := res.Marshal()
+			r.NoError(err)
+			var got map[string]any
+			r.NoError(json.Unmarshal(data, &got))
+			r.Equal(want, got)
+		})
+	}
+}
+
+func TestParseDescriptions(t *testing.T) {
+	r := require.New(t)
+	// mon descriptions of older releases encode req and n as strings
+	data := []byte(`{
+		"cmd001": {"sig": ["osd", "pool", "ls", {"name": "detail", "type": "CephChoices", "strings": "detail", "req": "false"}], "help": "list pools", "module": "osd", "perm": "r", "flags": 0},
+		"cmd010": {"sig": ["balancer", "status"], "help": "", "module": "mgr", "perm": "r", "flags": 8},
+		"cmd002": {"sig": ["report", {"name": "tags", "type": "CephString", "n": "N", "req": "false"}], "help": "", "module": "mon", "perm": "r", "flags": 0},
+		"cmd1000": {"sig": ["osd", "pool", "ls"], "help": "second signature", "module": "osd", "perm": "r", "flags": 0}
+	}`)
+	cmds, err := parseDescriptions(data, 0)
+	r.NoError(err)
+	r.Len(cmds, 4)
+	r.Equal("osd pool ls", cmds[0].Prefix)
+	r.False(cmds[0].Args[0].Required)
+	r.Equal("report", cmds[1].Prefix)
+	r.True(cmds[1].Args[0].List)
+	r.True(cmds[2].Mgr())
+	r.False(cmds[0].Mgr())
+	r.Equal("second signature", cmds[3].Help, "cmd1000 is after cmd010")
+
+	idx := newIndex(cmds)
+	r.Len(idx.List("osd pool ls"), 2)
+	r.Len(idx.List(""), 4)
+}
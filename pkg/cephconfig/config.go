@@ -160,8 +160,7 @@ func NewConfig(ctx context.Context, radosSvc *rados.Svc, skipUpdate bool) (*Conf
 		return &Config{params: sortedBase}, nil
 	}
 
-	const monCmd = `{"prefix": "config ls", "format": "json"}`
-	cmdRes, err := radosSvc.ExecMon(ctx, monCmd)
+	cmdRes, err := radosSvc.ExecMon(ctx, rados.MonCmd{Prefix: "config ls", Format: "json"})
 	if err != nil {
 		logger.Err(err).Msg("Failed to execute 'config ls' command")
 		return nil, err
@@ -196,7 +195,7 @@ func NewConfig(ctx context.Context, radosSvc *rados.Svc, skipUpdate bool) (*Conf
 func fetchParamDetailFromCluster(ctx context.Context, radosSvc *rados.Svc, paramName string) (ConfigParamInfo, error) {
 	// Execute 'ceph config help' command for this parameter
 	// Note: The cmd string for 'config help' uses 'key' and not 'name'
-	monCmd := fmt.Sprintf(`{"prefix": "config help", "key": "%s", "format": "json"}`, paramName)
+	monCmd := rados.MonCmd{Prefix: "config help", Format: "json", Args: map[string]any{"key": paramName}}
 	cmdRes, err := radosSvc.ExecMon(ctx, monCmd)
 	if err != nil {
 		return ConfigParamInfo{}, fmt.Errorf("failed to execute 'config help' command: %w", err)
@@ -0,0 +1,144 @@
+package cephconfig
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+var (
+	sizeRegexp     = regexp.MustCompile(`^([0-9]+)\s*([KMGTPE]?)(i?B?)$`)
+	timespanRegexp = regexp.MustCompile(`^(\s*[0-9]+(\.[0-9]+)?\s*(ms|s|sec|second|seconds|m|min|minute|minutes|h|hr|hour|hours|d|day|days|w|wk|week|weeks|mo|month|months|y|yr|year|years)?)+\s*$`)
+	uuidRegexp     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Get returns parameter info by name
+func (c *Config) Get(name string) (ConfigParamInfo, bool) {
+	i := sort.Search(len(c.params), func(i int) bool {
+		return c.params[i].Name >= name
+	})
+	if i < len(c.params) && c.params[i].Name == name {
+		return c.params[i], true
+	}
+	return ConfigParamInfo{}, false
+}
+
+// IsModuleOption reports whether name is a mgr module option, e.g: "mgr/dashboard/ssl".
+// Module options are not part of config index and cannot be validated.
+func IsModuleOption(name string) bool {
+	return strings.HasPrefix(name, "mgr/")
+}
+
+// Validate checks that value can be set for the parameter according to its type, enum values and min/max.
+// Returns parameter info for known parameters.
+func (c *Config) Validate(name, value string) (ConfigParamInfo, error) {
+	info, ok := c.Get(name)
+	if !ok {
+		return ConfigParamInfo{}, fmt.Errorf("%w: unknown config parameter %q", types.ErrInvalidArg, name)
+	}
+	num, isNum, err := parseValue(info.Type, value)
+	if err != nil {
+		return info, fmt.Errorf("%w: invalid value %q for %s parameter %q: %v", types.ErrInvalidArg, value, info.Type, name, err)
+	}
+	if len(info.EnumValues) != 0 && !contains(info.EnumValues, value) {
+		return info, fmt.Errorf("%w: invalid value %q for parameter %q: must be one of %v", types.ErrInvalidArg, value, name, info.EnumValues)
+	}
+	if !isNum {
+		return info, nil
+	}
+	if minVal := ParseMinMax(info.Min); minVal != nil && num < *minVal {
+		return info, fmt.Errorf("%w: value %q for parameter %q is less than min %v", types.ErrInvalidArg, value, name, *minVal)
+	}
+	if maxVal := ParseMinMax(info.Max); maxVal != nil && num > *maxVal {
+		return info, fmt.Errorf("%w: value %q for parameter %q is greater than max %v", types.ErrInvalidArg, value, name, *maxVal)
+	}
+	return info, nil
+}
+
+// parseValue checks value format for given param type.
+// Returns numeric representation of the value if it can be compared with min and max.
+func parseValue(paramType, value string) (float64, bool, error) {
+	switch paramType {
+	case "bool":
+		switch strings.ToLower(value) {
+		case "true", "false":
+			return 0, false, nil
+		}
+		// ceph treats any integer as bool
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return 0, false, fmt.Errorf("expected true, false or integer")
+		}
+		return 0, false, nil
+	case "int":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("expected integer")
+		}
+		return float64(v), true, nil
+	case "uint":
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("expected unsigned integer")
+		}
+		return float64(v), true, nil
+	case "float":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("expected float")
+		}
+		return v, true, nil
+	case "size":
+		v, err := parseSize(value)
+		if err != nil {
+			return 0, false, err
+		}
+		return v, true, nil
+	case "secs", "millisecs":
+		// plain number can be compared with min/max, value with units is only checked for format
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v, true, nil
+		}
+		if !timespanRegexp.MatchString(value) {
+			return 0, false, fmt.Errorf("expected time span, e.g: 30, 5m, 1h")
+		}
+		return 0, false, nil
+	case "uuid":
+		if !uuidRegexp.MatchString(value) {
+			return 0, false, fmt.Errorf("expected uuid")
+		}
+		return 0, false, nil
+	default:
+		// str, addr, addrvec
+		return 0, false, nil
+	}
+}
+
+// parseSize parses size in bytes with optional IEC unit, e.g: 1024, 4K, 4Ki, 4KiB, 4G.
+func parseSize(value string) (float64, error) {
+	m := sizeRegexp.FindStringSubmatch(value)
+	if m == nil {
+		return 0, fmt.Errorf("expected size in bytes with optional unit, e.g: 1024, 4K, 1G")
+	}
+	v, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected size in bytes with optional unit, e.g: 1024, 4K, 1G")
+	}
+	res := float64(v)
+	if m[2] != "" {
+		res *= float64(uint64(1) << (10 * (strings.Index("KMGTPE", m[2]) + 1)))
+	}
+	return res, nil
+}
+
+func contains(list []string, val string) bool {
+	for _, v := range list {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package cephconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Get(t *testing.T) {
+	req := require.New(t)
+	cfg, err := NewConfig(context.Background(), nil, true)
+	req.NoError(err)
+
+	info, ok := cfg.Get("osd_max_backfills")
+	req.True(ok)
+	req.Equal("osd_max_backfills", info.Name)
+	req.Equal("uint", info.Type)
+
+	_, ok = cfg.Get("this_param_does_not_exist")
+	req.False(ok)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	cfg, err := NewConfig(context.Background(), nil, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		param   string
+		value   string
+		wantErr bool
+	}{
+		{name: "unknown param", param: "this_param_does_not_exist", value: "1", wantErr: true},
+		{name: "bool true", param: "mon_allow_pool_delete", value: "true"},
+		{name: "bool int", param: "mon_allow_pool_delete", value: "0"},
+		{name: "bool invalid", param: "mon_allow_pool_delete", value: "maybe", wantErr: true},
+		{name: "uint", param: "osd_max_backfills", value: "3"},
+		{name: "uint negative", param: "osd_max_backfills", value: "-3", wantErr: true},
+		{name: "uint not a number", param: "osd_max_backfills", value: "three", wantErr: true},
+		{name: "uint max", param: "bluestore_retry_disk_reads", value: "255"},
+		{name: "uint above max", param: "bluestore_retry_disk_reads", value: "256", wantErr: true},
+		{name: "int below min", param: "compressor_zlib_winsize", value: "-16", wantErr: true},
+		{name: "int min", param: "compressor_zlib_winsize", value: "-15"},
+		{name: "float", param: "log_stop_at_utilization", value: "0.5"},
+		{name: "float above max", param: "log_stop_at_utilization", value: "1.5", wantErr: true},
+		{name: "size bytes", param: "osd_memory_target", value: "4294967296"},
+		{name: "size unit", param: "osd_memory_target", value: "4G"},
+		{name: "size iec unit", param: "osd_memory_target", value: "4GiB"},
+		{name: "size below min", param: "osd_memory_target", value: "512M", wantErr: true},
+		{name: "size invalid unit", param: "osd_memory_target", value: "4X", wantErr: true},
+		{name: "secs", param: "cephfs_mirror_mount_timeout", value: "30"},
+		{name: "secs with unit", param: "cephfs_mirror_mount_timeout", value: "5m"},
+		{name: "secs invalid", param: "cephfs_mirror_mount_timeout", value: "soon", wantErr: true},
+		{name: "enum", param: "osd_op_queue", value: "wpq"},
+		{name: "enum invalid", param: "osd_op_queue", value: "fifo", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := require.New(t)
+			_, err := cfg.Validate(tt.param, tt.value)
+			if tt.wantErr {
+				req.Error(err)
+				req.True(errors.Is(err, types.ErrInvalidArg))
+				return
+			}
+			req.NoError(err)
+		})
+	}
+}
+
+func TestIsModuleOption(t *testing.T) {
+	req := require.New(t)
+	req.True(IsModuleOption("mgr/dashboard/ssl"))
+	req.False(IsModuleOption("mgr_stats_period"))
+}
@@ -8,11 +8,14 @@ import (
 	"strings"
 
 	"github.com/clyso/ceph-api/pkg/api"
+	"github.com/clyso/ceph-api/pkg/audit"
 	"github.com/clyso/ceph-api/pkg/auth"
 	"github.com/clyso/ceph-api/pkg/log"
 	"github.com/clyso/ceph-api/pkg/metrics"
+	"github.com/clyso/ceph-api/pkg/operation"
 	"github.com/clyso/ceph-api/pkg/rados"
 	"github.com/clyso/ceph-api/pkg/trace"
+	"github.com/clyso/ceph-api/pkg/user"
 	"github.com/spf13/viper"
 )
 
@@ -37,11 +40,15 @@ type Config struct {
 
 	Auth auth.Config `yaml:"auth"`
 
+	Audit audit.Config `yaml:"audit"`
+
+	Operation operation.Config `yaml:"operation"`
+
 	App struct {
 		CreateAdmin   bool   `yaml:"createAdmin"`
 		AdminUsername string `yaml:"adminUsername"`
 		AdminPassword string `yaml:"adminPassword"`
-		BcryptPwdCost int    `yaml:"bcryptPwdCos"`
+		user.Config   `yaml:",inline" mapstructure:",squash"`
 	} `yaml:"app"`
 }
 
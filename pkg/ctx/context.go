@@ -7,6 +7,8 @@ import (
 type traceKey struct{}
 type userKey struct{}
 type permKey struct{}
+type resourcePermKey struct{}
+type rolesKey struct{}
 
 func SetTraceID(ctx context.Context, in string) context.Context {
 	return context.WithValue(ctx, traceKey{}, in)
@@ -37,3 +39,26 @@ func GetPermissions(ctx context.Context) map[string][]string {
 	res, _ := ctx.Value(permKey{}).(map[string][]string)
 	return res
 }
+
+// SetResourcePermissions sets permissions granted for resources. Maps scope to resource name glob to permissions.
+func SetResourcePermissions(ctx context.Context, in map[string]map[string][]string) context.Context {
+	if in == nil {
+		in = map[string]map[string][]string{}
+	}
+	return context.WithValue(ctx, resourcePermKey{}, in)
+}
+
+func GetResourcePermissions(ctx context.Context) map[string]map[string][]string {
+	res, _ := ctx.Value(resourcePermKey{}).(map[string]map[string][]string)
+	return res
+}
+
+// SetRoles sets roles of authenticated caller. Used to explain caller permissions.
+func SetRoles(ctx context.Context, in []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, in)
+}
+
+func GetRoles(ctx context.Context) []string {
+	res, _ := ctx.Value(rolesKey{}).([]string)
+	return res
+}
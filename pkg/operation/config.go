@@ -0,0 +1,10 @@
+package operation
+
+import "time"
+
+type Config struct {
+	// Finished operations older than retention are removed. Set 0 to keep them forever.
+	Retention time.Duration `yaml:"retention"`
+	// How often running operations are checked for cancellation and abandoned operations are resumed.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+}
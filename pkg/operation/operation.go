@@ -0,0 +1,384 @@
+package operation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// number of random bytes in operation id
+	idLen = 16
+
+	DefaultWaitTimeout = 30 * time.Second
+	MaxWaitTimeout     = 10 * time.Minute
+
+	defaultCheckInterval = 5 * time.Second
+	// unfinished operation is resumed if its heartbeat was not updated for this number of check intervals
+	staleIntervals   = 3
+	waitPollInterval = 500 * time.Millisecond
+	queueSize        = 100
+)
+
+// Handler performs operation of registered type with params passed to Start.
+// Handler must return when context is cancelled. Errors are expected to be grpc status errors.
+type Handler func(ctx context.Context, params json.RawMessage) error
+
+// Operation is a record of long-running operation.
+type Operation struct {
+	ID string `json:"id"`
+	// registered handler type, e.g: pool.delete
+	Type string `json:"type"`
+	// user.Scope and resource name of affected resource, used for permission checks
+	Scope  string          `json:"scope"`
+	Target string          `json:"target"`
+	Params json.RawMessage `json:"params,omitempty"`
+	// user or service account started operation
+	Username        string     `json:"username"`
+	CreateTime      time.Time  `json:"create_time"`
+	EndTime         *time.Time `json:"end_time,omitempty"`
+	Done            bool       `json:"done"`
+	CancelRequested bool       `json:"cancel_requested,omitempty"`
+	// set if finished operation failed
+	Error *Error `json:"error,omitempty"`
+	// updated by instance running operation. Operations with outdated heartbeat are resumed by any instance.
+	Heartbeat time.Time `json:"heartbeat"`
+}
+
+type Error struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+// Service runs long-running operations in background. Operation records are kept in config-key store,
+// so operations can be polled from any instance and unfinished operations are resumed after restart.
+// Handlers should be idempotent because resumed operation is executed from the beginning.
+type Service struct {
+	conf     Config
+	store    store
+	handlers map[string]Handler
+	queue    chan string
+	wg       sync.WaitGroup
+
+	// serializes operation record updates made by this instance.
+	// config-key has no conditional set, so concurrent updates from other instances may be lost.
+	mu sync.Mutex
+	// operations run by this instance
+	running map[string]*run
+}
+
+type run struct {
+	cancel   context.CancelFunc
+	canceled bool
+}
+
+func New(conf Config, radosSvc *rados.Svc) *Service {
+	return &Service{
+		conf:     conf,
+		store:    store{radosSvc: radosSvc},
+		handlers: map[string]Handler{},
+		queue:    make(chan string, queueSize),
+		running:  map[string]*run{},
+	}
+}
+
+// RegisterHandler registers handler for operation type. Must be called before Run.
+func (s *Service) RegisterHandler(typ string, h Handler) {
+	s.handlers[typ] = h
+}
+
+// Start stores new operation and queues it for execution.
+func (s *Service) Start(ctx context.Context, typ, scope, target string, params any) (Operation, error) {
+	if _, ok := s.handlers[typ]; !ok {
+		return Operation{}, fmt.Errorf("%w: unknown operation type %q", types.ErrInternal, typ)
+	}
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return Operation{}, err
+	}
+	id, err := newID()
+	if err != nil {
+		return Operation{}, err
+	}
+	now := time.Now().UTC()
+	op := Operation{
+		ID:         id,
+		Type:       typ,
+		Scope:      scope,
+		Target:     target,
+		Params:     rawParams,
+		Username:   xctx.GetUsername(ctx),
+		CreateTime: now,
+		Heartbeat:  now,
+	}
+	if err = s.store.put(ctx, op); err != nil {
+		return Operation{}, err
+	}
+	select {
+	case s.queue <- id:
+	default:
+		// queue is full, operation will be picked up as abandoned
+		zerolog.Ctx(ctx).Warn().Str("operation", id).Msg("operations queue is full")
+	}
+	zerolog.Ctx(ctx).Info().Str("operation", id).Str("type", typ).Str("target", target).Msg("operation started")
+	return op, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (Operation, error) {
+	return s.store.get(ctx, id)
+}
+
+// List returns all operations in creation order.
+func (s *Service) List(ctx context.Context) ([]Operation, error) {
+	return s.store.list(ctx)
+}
+
+// Cancel requests operation cancellation. Operation run by other instance is cancelled on its next check.
+func (s *Service) Cancel(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, err := s.store.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if op.Done {
+		return fmt.Errorf("%w: operation %q is already done", types.ErrFailedPrecondition, id)
+	}
+	op.CancelRequested = true
+	if err = s.store.put(ctx, op); err != nil {
+		return err
+	}
+	if r, ok := s.running[id]; ok {
+		r.canceled = true
+		r.cancel()
+	}
+	return nil
+}
+
+// Delete removes finished operation record.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, err := s.store.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !op.Done {
+		return fmt.Errorf("%w: operation %q is in progress, cancel it first", types.ErrFailedPrecondition, id)
+	}
+	return s.store.remove(ctx, id)
+}
+
+// Wait polls operation until it is done or timeout is reached and returns its latest state.
+func (s *Service) Wait(ctx context.Context, id string, timeout time.Duration) (Operation, error) {
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	timeout = min(timeout, MaxWaitTimeout)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+	for {
+		op, err := s.store.get(ctx, id)
+		if err != nil || op.Done {
+			return op, err
+		}
+		select {
+		case <-ctx.Done():
+			return Operation{}, ctx.Err()
+		case <-timer.C:
+			return op, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run executes started operations and resumes abandoned ones. Blocks until context is cancelled.
+// Operations interrupted by cancelled context are left unfinished and resumed on next start.
+func (s *Service) Run(ctx context.Context) error {
+	defer s.wg.Wait()
+	ticker := time.NewTicker(s.checkInterval())
+	defer ticker.Stop()
+	s.check(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case id := <-s.queue:
+			s.execute(ctx, id)
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+func (s *Service) checkInterval() time.Duration {
+	if s.conf.CheckInterval <= 0 {
+		return defaultCheckInterval
+	}
+	return s.conf.CheckInterval
+}
+
+// check updates heartbeat of running operations, applies cancellation requested on other instances,
+// resumes abandoned operations and removes expired ones.
+func (s *Service) check(ctx context.Context) {
+	ops, err := s.store.list(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Msg("unable to list operations")
+		return
+	}
+	now := time.Now()
+	staleAfter := staleIntervals * s.checkInterval()
+	for _, op := range ops {
+		switch {
+		case op.Done:
+			if s.conf.Retention > 0 && op.EndTime != nil && now.Sub(*op.EndTime) > s.conf.Retention {
+				if err = s.store.remove(ctx, op.ID); err != nil {
+					zerolog.Ctx(ctx).Err(err).Str("operation", op.ID).Msg("unable to remove expired operation")
+				}
+			}
+		case s.isRunning(op.ID):
+			s.heartbeat(ctx, op.ID)
+		case now.Sub(op.Heartbeat) > staleAfter:
+			zerolog.Ctx(ctx).Info().Str("operation", op.ID).Msg("resuming abandoned operation")
+			s.execute(ctx, op.ID)
+		}
+	}
+}
+
+func (s *Service) isRunning(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.running[id]
+	return ok
+}
+
+func (s *Service) heartbeat(ctx context.Context, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.running[id]
+	if !ok {
+		return
+	}
+	op, err := s.store.get(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("operation", id).Msg("unable to get running operation")
+		return
+	}
+	if op.Done {
+		return
+	}
+	if op.CancelRequested && !r.canceled {
+		r.canceled = true
+		r.cancel()
+	}
+	op.Heartbeat = time.Now().UTC()
+	if err = s.store.put(ctx, op); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("operation", id).Msg("unable to update operation heartbeat")
+	}
+}
+
+func (s *Service) execute(ctx context.Context, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.running[id]; ok {
+		return
+	}
+	logger := zerolog.Ctx(ctx).With().Str("operation", id).Logger()
+	op, err := s.store.get(ctx, id)
+	if err != nil {
+		logger.Err(err).Msg("unable to get operation")
+		return
+	}
+	if op.Done {
+		return
+	}
+	if op.CancelRequested {
+		s.finish(ctx, id, status.Error(codes.Canceled, "operation cancelled"))
+		return
+	}
+	h, ok := s.handlers[op.Type]
+	if !ok {
+		s.finish(ctx, id, status.Errorf(codes.Unimplemented, "unknown operation type %q", op.Type))
+		return
+	}
+	op.Heartbeat = time.Now().UTC()
+	if err = s.store.put(ctx, op); err != nil {
+		logger.Err(err).Msg("unable to update operation heartbeat")
+		return
+	}
+	opCtx, cancel := context.WithCancel(logger.WithContext(ctx))
+	r := &run{cancel: cancel}
+	s.running[id] = r
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		err := h(opCtx, op.Params)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.running, id)
+		if err != nil && ctx.Err() != nil && !r.canceled {
+			logger.Info().Msg("operation interrupted by shutdown, it will be resumed")
+			return
+		}
+		if err != nil && r.canceled {
+			err = status.Error(codes.Canceled, "operation cancelled")
+		}
+		s.finish(context.WithoutCancel(opCtx), id, err)
+	}()
+}
+
+// finish stores operation result. Caller must hold lock.
+func (s *Service) finish(ctx context.Context, id string, opErr error) {
+	op, err := s.store.get(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("operation", id).Msg("unable to get finished operation")
+		return
+	}
+	now := time.Now().UTC()
+	op.Done, op.EndTime = true, &now
+	if opErr != nil {
+		op.Error = newError(opErr)
+		zerolog.Ctx(ctx).Warn().Err(opErr).Str("operation", id).Msg("operation failed")
+	} else {
+		zerolog.Ctx(ctx).Info().Str("operation", id).Msg("operation done")
+	}
+	if err = s.store.put(ctx, op); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("operation", id).Msg("unable to store operation result")
+	}
+}
+
+func newError(err error) *Error {
+	st := status.Convert(err)
+	res := &Error{Code: st.Code(), Message: st.Message()}
+	// api errors keep original message in error info
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason != "" {
+			res.Message = info.Reason
+		}
+	}
+	return res
+}
+
+func newID() (string, error) {
+	b := make([]byte, idLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
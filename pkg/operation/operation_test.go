@@ -0,0 +1,171 @@
+//go:build mock
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestRados(t *testing.T) *rados.Svc {
+	t.Helper()
+	conn, err := rados.NewMockConn()
+	require.NoError(t, err)
+	radosSvc, err := rados.New(conn)
+	require.NoError(t, err)
+	return radosSvc
+}
+
+func runService(t *testing.T, s *Service) context.CancelFunc {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		_ = s.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func TestService_Run(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s := New(Config{CheckInterval: 10 * time.Millisecond}, newTestRados(t))
+	var gotParams string
+	s.RegisterHandler("test.ok", func(ctx context.Context, params json.RawMessage) error {
+		gotParams = string(params)
+		return nil
+	})
+	s.RegisterHandler("test.fail", func(ctx context.Context, params json.RawMessage) error {
+		return status.Error(codes.FailedPrecondition, "pool is in use")
+	})
+	runService(t, s)
+
+	_, err := s.Start(ctx, "test.unknown", "pool", "p1", nil)
+	r.ErrorIs(err, types.ErrInternal)
+
+	okOp, err := s.Start(ctx, "test.ok", "pool", "p1", map[string]string{"name": "p1"})
+	r.NoError(err)
+	r.False(okOp.Done)
+	okOp, err = s.Wait(ctx, okOp.ID, time.Second)
+	r.NoError(err)
+	r.True(okOp.Done)
+	r.Nil(okOp.Error)
+	r.NotNil(okOp.EndTime)
+	r.JSONEq(`{"name":"p1"}`, gotParams)
+
+	failOp, err := s.Start(ctx, "test.fail", "pool", "p2", nil)
+	r.NoError(err)
+	failOp, err = s.Wait(ctx, failOp.ID, time.Second)
+	r.NoError(err)
+	r.True(failOp.Done)
+	r.NotNil(failOp.Error)
+	r.EqualValues(codes.FailedPrecondition, failOp.Error.Code)
+	r.EqualValues("pool is in use", failOp.Error.Message)
+
+	ops, err := s.List(ctx)
+	r.NoError(err)
+	r.Len(ops, 2)
+	r.EqualValues(okOp.ID, ops[0].ID)
+	r.EqualValues(failOp.ID, ops[1].ID)
+
+	r.ErrorIs(s.Cancel(ctx, okOp.ID), types.ErrFailedPrecondition, "done operation cannot be cancelled")
+	r.NoError(s.Delete(ctx, okOp.ID))
+	_, err = s.Get(ctx, okOp.ID)
+	r.ErrorIs(err, types.ErrNotFound)
+	_, err = s.Get(ctx, "../accessdb")
+	r.ErrorIs(err, types.ErrInvalidArg)
+}
+
+func TestService_Cancel(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s := New(Config{CheckInterval: 10 * time.Millisecond}, newTestRados(t))
+	s.RegisterHandler("test.block", func(ctx context.Context, params json.RawMessage) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	runService(t, s)
+
+	op, err := s.Start(ctx, "test.block", "osd", "osd.1", nil)
+	r.NoError(err)
+	op, err = s.Wait(ctx, op.ID, 50*time.Millisecond)
+	r.NoError(err)
+	r.False(op.Done, "wait returns running operation on timeout")
+	r.ErrorIs(s.Delete(ctx, op.ID), types.ErrFailedPrecondition, "running operation cannot be deleted")
+
+	r.NoError(s.Cancel(ctx, op.ID))
+	op, err = s.Wait(ctx, op.ID, time.Second)
+	r.NoError(err)
+	r.True(op.Done)
+	r.True(op.CancelRequested)
+	r.EqualValues(codes.Canceled, op.Error.Code)
+}
+
+func TestService_Resume(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	radosSvc := newTestRados(t)
+	conf := Config{CheckInterval: 10 * time.Millisecond}
+
+	s1 := New(conf, radosSvc)
+	started := make(chan struct{})
+	s1.RegisterHandler("test.op", func(ctx context.Context, params json.RawMessage) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	stop := runService(t, s1)
+	op, err := s1.Start(ctx, "test.op", "pool", "p1", nil)
+	r.NoError(err)
+	<-started
+
+	// operation interrupted by shutdown is not finished
+	stop()
+	op, err = s1.Get(ctx, op.ID)
+	r.NoError(err)
+	r.False(op.Done)
+
+	// and resumed by next instance
+	s2 := New(conf, radosSvc)
+	s2.RegisterHandler("test.op", func(ctx context.Context, params json.RawMessage) error {
+		return nil
+	})
+	runService(t, s2)
+	op, err = s2.Wait(ctx, op.ID, time.Second)
+	r.NoError(err)
+	r.True(op.Done)
+	r.Nil(op.Error)
+}
+
+func TestService_Retention(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s := New(Config{CheckInterval: 10 * time.Millisecond, Retention: 50 * time.Millisecond}, newTestRados(t))
+	s.RegisterHandler("test.ok", func(ctx context.Context, params json.RawMessage) error {
+		return nil
+	})
+	runService(t, s)
+
+	op, err := s.Start(ctx, "test.ok", "pool", "p1", nil)
+	r.NoError(err)
+	r.Eventually(func() bool {
+		_, err := s.Get(ctx, op.ID)
+		return err != nil
+	}, time.Second, 10*time.Millisecond, "finished operation is removed after retention")
+}
@@ -0,0 +1,100 @@
+package operation
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// config-key prefix of operation records
+const keyPrefix = "mgr/ceph-api/operations/"
+
+// store keeps operation records in config-key store, one key per operation.
+type store struct {
+	radosSvc *rados.Svc
+}
+
+func (s *store) get(ctx context.Context, id string) (Operation, error) {
+	if err := validateID(id); err != nil {
+		return Operation{}, err
+	}
+	res, err := s.exec(ctx, "config-key get", keyPrefix+id)
+	if errors.Is(err, types.RadosErrorNotFound) {
+		return Operation{}, fmt.Errorf("%w: operation %q not found", types.ErrNotFound, id)
+	}
+	if err != nil {
+		return Operation{}, err
+	}
+	var op Operation
+	if err = json.Unmarshal(res, &op); err != nil {
+		return Operation{}, fmt.Errorf("%w: unable to decode operation %q", err, id)
+	}
+	return op, nil
+}
+
+func (s *store) put(ctx context.Context, op Operation) error {
+	val, err := json.Marshal(&op)
+	if err != nil {
+		return err
+	}
+	_, err = s.radosSvc.ExecMonWithInputBuff(ctx, keyCmd("config-key set", keyPrefix+op.ID), val)
+	return err
+}
+
+func (s *store) remove(ctx context.Context, id string) error {
+	_, err := s.exec(ctx, "config-key rm", keyPrefix+id)
+	return err
+}
+
+// list returns all operations sorted by creation time.
+func (s *store) list(ctx context.Context) ([]Operation, error) {
+	res, err := s.exec(ctx, "config-key dump", keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	var dump map[string]string
+	if err = json.Unmarshal(res, &dump); err != nil {
+		return nil, fmt.Errorf("%w: unable to decode config-key dump", err)
+	}
+	ops := make([]Operation, 0, len(dump))
+	for key, val := range dump {
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		var op Operation
+		if err = json.Unmarshal([]byte(val), &op); err != nil {
+			return nil, fmt.Errorf("%w: unable to decode operation %q", err, key)
+		}
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].CreateTime.Equal(ops[j].CreateTime) {
+			return ops[i].ID < ops[j].ID
+		}
+		return ops[i].CreateTime.Before(ops[j].CreateTime)
+	})
+	return ops, nil
+}
+
+func (s *store) exec(ctx context.Context, prefix, key string) ([]byte, error) {
+	return s.radosSvc.ExecMon(ctx, keyCmd(prefix, key))
+}
+
+func keyCmd(prefix, key string) rados.MonCmd {
+	return rados.MonCmd{Prefix: prefix, Args: map[string]any{"key": key}}
+}
+
+// validateID checks that id was generated by newID, so it is safe to use in config-key name.
+func validateID(id string) error {
+	if b, err := hex.DecodeString(id); err != nil || len(b) != idLen {
+		return fmt.Errorf("%w: invalid operation name %q", types.ErrInvalidArg, id)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package rados
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// MonCmd is a mon or mgr command.
+// Command is marshaled to JSON with encoding/json, so user input in argument values
+// can't add or override command fields.
+type MonCmd struct {
+	// command name, e.g: osd pool create
+	Prefix string
+	// output format, e.g: json. Omitted if empty.
+	Format string
+	// command arguments by name. Values must be JSON serializable.
+	Args map[string]any
+}
+
+// Marshal returns JSON command accepted by ceph.
+func (c MonCmd) Marshal() ([]byte, error) {
+	if c.Prefix == "" {
+		return nil, fmt.Errorf("%w: command prefix is required", types.ErrInvalidArg)
+	}
+	cmd := make(map[string]any, len(c.Args)+2)
+	for name, val := range c.Args {
+		if name == "prefix" || name == "format" {
+			return nil, fmt.Errorf("%w: argument %q of command %q is reserved", types.ErrInvalidArg, name, c.Prefix)
+		}
+		cmd[name] = val
+	}
+	cmd["prefix"] = c.Prefix
+	if c.Format != "" {
+		cmd["format"] = c.Format
+	}
+	res, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to marshal command %q", err, c.Prefix)
+	}
+	return res, nil
+}
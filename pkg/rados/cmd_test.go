@@ -0,0 +1,94 @@
+package rados
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonCmd_Marshal(t *testing.T) {
+	r := require.New(t)
+	cmd := MonCmd{Prefix: "auth add", Format: "json", Args: map[string]any{
+		"entity": "client.foo",
+		"caps":   []string{"mon", "allow r"},
+	}}
+	res, err := cmd.Marshal()
+	r.NoError(err)
+	r.JSONEq(`{"prefix": "auth add", "format": "json", "entity": "client.foo", "caps": ["mon", "allow r"]}`, string(res))
+
+	res, err = MonCmd{Prefix: "status"}.Marshal()
+	r.NoError(err)
+	r.JSONEq(`{"prefix": "status"}`, string(res), "format is omitted if empty")
+
+	_, err = MonCmd{}.Marshal()
+	r.ErrorIs(err, types.ErrInvalidArg)
+	_, err = MonCmd{Prefix: "auth del", Args: map[string]any{"prefix": "auth rm"}}.Marshal()
+	r.ErrorIs(err, types.ErrInvalidArg)
+	_, err = MonCmd{Prefix: "auth ls", Args: map[string]any{"format": "plain"}}.Marshal()
+	r.ErrorIs(err, types.ErrInvalidArg)
+}
+
+// FuzzMonCmd_StringArg checks that string argument can't add or override command fields,
+// e.g: entity `client.foo", "prefix": "auth rm` injected into JSON template.
+func FuzzMonCmd_StringArg(f *testing.F) {
+	f.Add("client.admin")
+	f.Add(`client.foo", "prefix": "auth rm`)
+	f.Add(`client.foo", "format": "plain`)
+	f.Add(`\", \"caps\": [\"mon\", \"allow *\"]`)
+	f.Add("client.foo\"}\n{\"prefix\": \"osd pool delete\"")
+	f.Add("\x00 \ufeff'`")
+	f.Fuzz(func(t *testing.T, entity string) {
+		cmd := MonCmd{Prefix: "auth del", Format: "json", Args: map[string]any{"entity": entity}}
+		data, err := cmd.Marshal()
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("command %s is not valid JSON: %v", data, err)
+		}
+		if len(got) != 3 || got["prefix"] != "auth del" || got["format"] != "json" {
+			t.Fatalf("command structure changed by argument %q: %s", entity, data)
+		}
+		// invalid UTF-8 is replaced by encoding/json, but still can't escape the string value
+		if utf8.ValidString(entity) && got["entity"] != entity {
+			t.Fatalf("argument %q changed: %q", entity, got["entity"])
+		}
+	})
+}
+
+// FuzzMonCmd_CapsArg checks list and map argument values built from user input, e.g: auth caps and erasure code profile.
+func FuzzMonCmd_CapsArg(f *testing.F) {
+	f.Add("mon", "allow r")
+	f.Add(`mon", "allow *"], "prefix": "auth rm`, `allow r"], "entity": "client.admin`)
+	f.Add("]", "[")
+	f.Fuzz(func(t *testing.T, key, val string) {
+		cmd := MonCmd{Prefix: "auth caps", Args: map[string]any{
+			"entity":  "client.foo",
+			"caps":    []string{key, val},
+			"profile": map[string]string{key: val},
+		}}
+		data, err := cmd.Marshal()
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		var got struct {
+			Prefix  string            `json:"prefix"`
+			Entity  string            `json:"entity"`
+			Caps    []string          `json:"caps"`
+			Profile map[string]string `json:"profile"`
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&got); err != nil {
+			t.Fatalf("command structure changed by %q, %q: %s: %v", key, val, data, err)
+		}
+		if got.Prefix != "auth caps" || got.Entity != "client.foo" || len(got.Caps) != 2 || len(got.Profile) != 1 {
+			t.Fatalf("command structure changed by %q, %q: %s", key, val, data)
+		}
+	})
+}
@@ -15,5 +15,14 @@ type RadosConnInterface interface {
 	MonCommand(in []byte) (out []byte, cmdStatus string, err error)
 	MonCommandWithInputBuffer(cmd []byte, in []byte) (out []byte, cmdStatus string, err error)
 	MgrCommand(in [][]byte) (out []byte, cmdStatus string, err error)
+	// Omap operations on object in pool namespace.
+	SetOmap(pool, namespace, oid string, pairs map[string][]byte) error
+	// ListOmap calls listFn for up to maxReturn omap keys after startAfter in sorted order.
+	ListOmap(pool, namespace, oid, startAfter string, maxReturn int64, listFn func(key string, value []byte)) error
+	RmOmapKeys(pool, namespace, oid string, keys []string) error
+	// LockExclusive takes exclusive lock with given name and cookie on object in pool namespace.
+	// Lock expires after duration. Returns false if lock is held by other client.
+	LockExclusive(pool, namespace, oid, name, cookie string, duration time.Duration) (bool, error)
+	Unlock(pool, namespace, oid, name, cookie string) error
 	Shutdown()
 }
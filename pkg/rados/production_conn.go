@@ -4,6 +4,7 @@ package rados
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/ceph/go-ceph/rados"
 )
@@ -47,3 +48,63 @@ func NewRadosConn(conf Config) (RadosConnInterface, error) {
 	prodConn := &ProductionConn{Conn: conn}
 	return prodConn, nil
 }
+
+func (c *ProductionConn) SetOmap(pool, namespace, oid string, pairs map[string][]byte) error {
+	ioctx, err := c.openIOContext(pool, namespace)
+	if err != nil {
+		return err
+	}
+	defer ioctx.Destroy()
+	return ioctx.SetOmap(oid, pairs)
+}
+
+func (c *ProductionConn) ListOmap(pool, namespace, oid, startAfter string, maxReturn int64, listFn func(key string, value []byte)) error {
+	ioctx, err := c.openIOContext(pool, namespace)
+	if err != nil {
+		return err
+	}
+	defer ioctx.Destroy()
+	return ioctx.ListOmapValues(oid, startAfter, "", maxReturn, listFn)
+}
+
+func (c *ProductionConn) RmOmapKeys(pool, namespace, oid string, keys []string) error {
+	ioctx, err := c.openIOContext(pool, namespace)
+	if err != nil {
+		return err
+	}
+	defer ioctx.Destroy()
+	return ioctx.RmOmapKeys(oid, keys)
+}
+
+func (c *ProductionConn) LockExclusive(pool, namespace, oid, name, cookie string, duration time.Duration) (bool, error) {
+	ioctx, err := c.openIOContext(pool, namespace)
+	if err != nil {
+		return false, err
+	}
+	defer ioctx.Destroy()
+	// -EBUSY if lock is held by other client
+	ret, err := ioctx.LockExclusive(oid, name, cookie, "ceph-api", duration, nil)
+	if err != nil {
+		return false, err
+	}
+	return ret == 0, nil
+}
+
+func (c *ProductionConn) Unlock(pool, namespace, oid, name, cookie string) error {
+	ioctx, err := c.openIOContext(pool, namespace)
+	if err != nil {
+		return err
+	}
+	defer ioctx.Destroy()
+	_, err = ioctx.Unlock(oid, name, cookie)
+	return err
+}
+
+func (c *ProductionConn) openIOContext(pool, namespace string) (*rados.IOContext, error) {
+	ioctx, err := c.OpenIOContext(pool)
+	if err != nil {
+		return nil, err
+	}
+	ioctx.SetNamespace(namespace)
+	return ioctx, nil
+}
@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"math/rand"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
 )
 
 //go:embed mock-data/mon/*.json mock-data/mon-input/*.json mock-data/mgr/*.json
@@ -50,15 +54,38 @@ func NewMockConn() (RadosConnInterface, error) {
 		monInputResponses: monInputResponses,
 		mgrResponses:      mgrResponses,
 		rng:               rand.New(rand.NewSource(time.Now().UnixNano())),
+		configKeys:        map[string][]byte{},
+		omaps:             map[string]map[string][]byte{},
+		locks:             map[string]mockLock{},
 	}, nil
 }
 
 // MockConn implements RadosConnInterface and returns random responses from a set of preloaded JSON files
+// Omap operations and config-key values set by client are served from memory.
 type MockConn struct {
 	monResponses      map[string][][]byte
 	monInputResponses map[string][][]byte
 	mgrResponses      map[string][][]byte
 	rng               *rand.Rand
+
+	mu         sync.Mutex
+	configKeys map[string][]byte
+	omaps      map[string]map[string][]byte
+	locks      map[string]mockLock
+	// audit log entries of dispatched mon commands
+	auditLog []map[string]any
+}
+
+// max number of audit log entries kept in memory
+const mockAuditLogSize = 100
+
+// ceph log priorities ordered by level
+var mockLogLevels = map[string]int{"debug": 0, "info": 1, "sec": 2, "warn": 3, "error": 4}
+var mockLogPriorities = map[string]int{"[DBG]": 0, "[INF]": 1, "[SEC]": 2, "[WRN]": 3, "[ERR]": 4}
+
+type mockLock struct {
+	cookie  string
+	expires time.Time
 }
 
 // randomly selects a response from the given slice
@@ -88,11 +115,54 @@ func normalize(cmdJSON []byte) (string, error) {
 	return strings.ReplaceAll(prefix, " ", "_"), nil
 }
 
+// configKey extracts the "key" field from a JSON config-key command
+func configKey(cmdJSON []byte) string {
+	var cmdData struct {
+		Key string `json:"key"`
+	}
+	_ = json.Unmarshal(cmdJSON, &cmdData)
+	return cmdData.Key
+}
+
 func (mc *MockConn) MonCommand(in []byte) ([]byte, string, error) {
 	prefix, err := normalize(in)
 	if err != nil {
 		return nil, "", err
 	}
+	mc.logAudit(in)
+	switch prefix {
+	case "log_last":
+		return mc.logLast(in)
+	case "config-key_get":
+		mc.mu.Lock()
+		val, ok := mc.configKeys[configKey(in)]
+		mc.mu.Unlock()
+		if ok && val == nil {
+			return nil, "", types.RadosErrorNotFound
+		}
+		if ok {
+			return val, "OK", nil
+		}
+	case "config-key_rm":
+		mc.mu.Lock()
+		// removed key is kept as nil to not fall back to preloaded response on get
+		mc.configKeys[configKey(in)] = nil
+		mc.mu.Unlock()
+		return nil, "OK", nil
+	case "config-key_dump":
+		// only keys set by client are dumped, "key" is used as prefix
+		keyPrefix := configKey(in)
+		mc.mu.Lock()
+		dump := map[string]string{}
+		for k, v := range mc.configKeys {
+			if v != nil && strings.HasPrefix(k, keyPrefix) {
+				dump[k] = string(v)
+			}
+		}
+		mc.mu.Unlock()
+		resp, err := json.Marshal(dump)
+		return resp, "OK", err
+	}
 	responses, exists := mc.monResponses[prefix]
 	if !exists || len(responses) == 0 {
 		return nil, "", fmt.Errorf("unknown monitor command prefix: %s", prefix)
@@ -101,11 +171,84 @@ func (mc *MockConn) MonCommand(in []byte) ([]byte, string, error) {
 	return resp, "OK", err
 }
 
+// logAudit adds audit log entry for mon command the same way as ceph mon does on command dispatch.
+func (mc *MockConn) logAudit(cmd []byte) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	seq := 1
+	if n := len(mc.auditLog); n != 0 {
+		seq = mc.auditLog[n-1]["seq"].(int) + 1
+	}
+	mc.auditLog = append(mc.auditLog, map[string]any{
+		"name":     "mon.a",
+		"rank":     "mon.0",
+		"stamp":    time.Now().Format("2006-01-02T15:04:05.000000-0700"),
+		"seq":      seq,
+		"channel":  "audit",
+		"priority": "[INF]",
+		"message":  fmt.Sprintf("from='client.admin' entity='client.admin' cmd=[%s]: dispatch", cmd),
+	})
+	if len(mc.auditLog) > mockAuditLogSize {
+		mc.auditLog = mc.auditLog[1:]
+	}
+}
+
+// logLast returns preloaded and audit log entries filtered by channel and level arguments.
+func (mc *MockConn) logLast(cmd []byte) ([]byte, string, error) {
+	var args struct {
+		Num     int    `json:"num"`
+		Level   string `json:"level"`
+		Channel string `json:"channel"`
+	}
+	if err := json.Unmarshal(cmd, &args); err != nil {
+		return nil, "", err
+	}
+	if args.Channel == "" {
+		args.Channel = "cluster"
+	}
+	minLevel, ok := mockLogLevels[args.Level]
+	if !ok {
+		minLevel = mockLogLevels["info"]
+	}
+	resp, err := mc.selectRandomResponse(mc.monResponses["log_last"])
+	if err != nil {
+		return nil, "", err
+	}
+	var entries []map[string]any
+	if err = json.Unmarshal(resp, &entries); err != nil {
+		return nil, "", err
+	}
+	mc.mu.Lock()
+	entries = append(entries, mc.auditLog...)
+	mc.mu.Unlock()
+	res := make([]map[string]any, 0, len(entries))
+	for _, e := range entries {
+		if args.Channel != "*" && e["channel"] != args.Channel {
+			continue
+		}
+		priority, _ := e["priority"].(string)
+		if mockLogPriorities[priority] < minLevel {
+			continue
+		}
+		res = append(res, e)
+	}
+	if args.Num > 0 && len(res) > args.Num {
+		res = res[len(res)-args.Num:]
+	}
+	out, err := json.Marshal(res)
+	return out, "OK", err
+}
+
 func (mc *MockConn) MonCommandWithInputBuffer(cmd []byte, in []byte) ([]byte, string, error) {
 	prefix, err := normalize(cmd)
 	if err != nil {
 		return nil, "", err
 	}
+	if prefix == "config-key_set" {
+		mc.mu.Lock()
+		mc.configKeys[configKey(cmd)] = append([]byte(nil), in...)
+		mc.mu.Unlock()
+	}
 	responses, exists := mc.monInputResponses[prefix]
 	if !exists || len(responses) == 0 {
 		return nil, "", fmt.Errorf("unknown monitor command with input prefix: %s", prefix)
@@ -130,6 +273,80 @@ func (mc *MockConn) MgrCommand(in [][]byte) ([]byte, string, error) {
 	return resp, "OK", err
 }
 
+func (mc *MockConn) SetOmap(pool, namespace, oid string, pairs map[string][]byte) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	obj := pool + "/" + namespace + "/" + oid
+	if mc.omaps[obj] == nil {
+		mc.omaps[obj] = map[string][]byte{}
+	}
+	for k, v := range pairs {
+		mc.omaps[obj][k] = v
+	}
+	return nil
+}
+
+func (mc *MockConn) ListOmap(pool, namespace, oid, startAfter string, maxReturn int64, listFn func(key string, value []byte)) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	omap, ok := mc.omaps[pool+"/"+namespace+"/"+oid]
+	if !ok {
+		return types.RadosErrorNotFound
+	}
+	keys := make([]string, 0, len(omap))
+	for k := range omap {
+		if k > startAfter {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if int64(i) >= maxReturn {
+			break
+		}
+		listFn(k, omap[k])
+	}
+	return nil
+}
+
+func (mc *MockConn) RmOmapKeys(pool, namespace, oid string, keys []string) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	omap, ok := mc.omaps[pool+"/"+namespace+"/"+oid]
+	if !ok {
+		return types.RadosErrorNotFound
+	}
+	for _, k := range keys {
+		delete(omap, k)
+	}
+	return nil
+}
+
+func (mc *MockConn) LockExclusive(pool, namespace, oid, name, cookie string, duration time.Duration) (bool, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	key := pool + "/" + namespace + "/" + oid + "/" + name
+	if l, ok := mc.locks[key]; ok && (l.expires.IsZero() || time.Now().Before(l.expires)) {
+		return false, nil
+	}
+	l := mockLock{cookie: cookie}
+	if duration != 0 {
+		l.expires = time.Now().Add(duration)
+	}
+	mc.locks[key] = l
+	return true, nil
+}
+
+func (mc *MockConn) Unlock(pool, namespace, oid, name, cookie string) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	key := pool + "/" + namespace + "/" + oid + "/" + name
+	if l, ok := mc.locks[key]; ok && l.cookie == cookie {
+		delete(mc.locks, key)
+	}
+	return nil
+}
+
 func (mc *MockConn) Shutdown() {
 	// No-op
 }
@@ -19,10 +19,19 @@ func TestMockConnAllEmbeddedCommands(t *testing.T) {
 	}
 
 	monCommands := []string{
+		"config dump",
+		"config get",
+		"config log",
+		"config ls",
 		"config-key get",
+		"get_command_descriptions",
+		"log last",
 		"mon dump",
 		"osd crush dump",
 		"osd dump",
+		"osd erasure-code-profile get",
+		"osd erasure-code-profile ls",
+		"osd pool ls",
 		"pg dump",
 		"report",
 		"status",
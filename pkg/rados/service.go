@@ -2,10 +2,18 @@ package rados
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
 
+	"github.com/clyso/ceph-api/pkg/types"
 	"github.com/rs/zerolog"
 )
 
+// how often lock held by other client is retried
+const lockRetryInterval = 100 * time.Millisecond
+
 type Svc struct {
 	conn RadosConnInterface
 }
@@ -14,11 +22,15 @@ func New(radosConn RadosConnInterface) (*Svc, error) {
 	return &Svc{conn: radosConn}, nil
 }
 
-func (s *Svc) ExecMon(ctx context.Context, cmd string) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", cmd).Logger()
+func (s *Svc) ExecMon(ctx context.Context, cmd MonCmd) ([]byte, error) {
+	cmdBytes, err := cmd.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", string(cmdBytes)).Logger()
 
 	logger.Debug().Msg("executing mon command")
-	cmdRes, cmdStatus, err := s.conn.MonCommand([]byte(cmd))
+	cmdRes, cmdStatus, err := s.conn.MonCommand(cmdBytes)
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mon command executed with error")
 		return nil, err
@@ -30,11 +42,15 @@ func (s *Svc) ExecMon(ctx context.Context, cmd string) ([]byte, error) {
 	return cmdRes, nil
 }
 
-func (s *Svc) ExecMonWithInputBuff(ctx context.Context, cmd string, inputBuffer []byte) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", cmd).Logger()
+func (s *Svc) ExecMonWithInputBuff(ctx context.Context, cmd MonCmd, inputBuffer []byte) ([]byte, error) {
+	cmdBytes, err := cmd.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	logger := zerolog.Ctx(ctx).With().Str("mon_cmd", string(cmdBytes)).Logger()
 
 	logger.Debug().Str("mon_cmd_buf", string(inputBuffer)).Msg("executing mon command with input buffer")
-	cmdRes, cmdStatus, err := s.conn.MonCommandWithInputBuffer([]byte(cmd), inputBuffer)
+	cmdRes, cmdStatus, err := s.conn.MonCommandWithInputBuffer(cmdBytes, inputBuffer)
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mon command with input buffer executed with error")
 		return nil, err
@@ -46,11 +62,15 @@ func (s *Svc) ExecMonWithInputBuff(ctx context.Context, cmd string, inputBuffer
 	return cmdRes, nil
 }
 
-func (s *Svc) ExecMgr(ctx context.Context, cmd string) ([]byte, error) {
-	logger := zerolog.Ctx(ctx).With().Str("mgr_cmd", cmd).Logger()
+func (s *Svc) ExecMgr(ctx context.Context, cmd MonCmd) ([]byte, error) {
+	cmdBytes, err := cmd.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	logger := zerolog.Ctx(ctx).With().Str("mgr_cmd", string(cmdBytes)).Logger()
 
 	logger.Debug().Msg("executing mgr command")
-	cmdRes, cmdStatus, err := s.conn.MgrCommand([][]byte{[]byte(cmd)})
+	cmdRes, cmdStatus, err := s.conn.MgrCommand([][]byte{cmdBytes})
 	if err != nil {
 		logger.Err(err).Str("cmd_status", cmdStatus).Msg("mgr command executed with error")
 		return nil, err
@@ -62,6 +82,67 @@ func (s *Svc) ExecMgr(ctx context.Context, cmd string) ([]byte, error) {
 	return cmdRes, nil
 }
 
+func (s *Svc) SetOmap(ctx context.Context, pool, namespace, oid string, pairs map[string][]byte) error {
+	err := s.conn.SetOmap(pool, namespace, oid, pairs)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("pool", pool).Str("oid", oid).Msg("unable to set omap")
+	}
+	return err
+}
+
+// ListOmap calls listFn for up to maxReturn omap keys of object after startAfter in sorted order.
+func (s *Svc) ListOmap(ctx context.Context, pool, namespace, oid, startAfter string, maxReturn int64, listFn func(key string, value []byte)) error {
+	err := s.conn.ListOmap(pool, namespace, oid, startAfter, maxReturn, listFn)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("pool", pool).Str("oid", oid).Msg("unable to list omap")
+	}
+	return err
+}
+
+func (s *Svc) RmOmapKeys(ctx context.Context, pool, namespace, oid string, keys []string) error {
+	err := s.conn.RmOmapKeys(pool, namespace, oid, keys)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("pool", pool).Str("oid", oid).Msg("unable to remove omap keys")
+	}
+	return err
+}
+
+// LockExclusive takes exclusive lock on object. Waits until lock held by other client is released or expired.
+// Lock expires after duration, so it is released even if holder crashed. Returns func to release the lock.
+func (s *Svc) LockExclusive(ctx context.Context, pool, namespace, oid, name string, duration time.Duration) (func(), error) {
+	cookie := make([]byte, 8)
+	if _, err := rand.Read(cookie); err != nil {
+		return nil, err
+	}
+	cookieStr := hex.EncodeToString(cookie)
+	logger := zerolog.Ctx(ctx).With().Str("pool", pool).Str("oid", oid).Str("lock", name).Logger()
+	// lock held by other client expires within its duration
+	deadline := time.Now().Add(duration + lockRetryInterval)
+	for {
+		ok, err := s.conn.LockExclusive(pool, namespace, oid, name, cookieStr, duration)
+		if err != nil {
+			logger.Err(err).Msg("unable to lock object")
+			return nil, err
+		}
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%w: object %q is locked by other client", types.ErrConflict, oid)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+	return func() {
+		if err := s.conn.Unlock(pool, namespace, oid, name, cookieStr); err != nil {
+			logger.Err(err).Msg("unable to unlock object")
+		}
+	}, nil
+}
+
 func (s *Svc) Close() {
 	s.conn.Shutdown()
 }
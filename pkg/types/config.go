@@ -0,0 +1,16 @@
+package types
+
+// ConfigChangeSet is an entry of "config log" command output.
+type ConfigChangeSet struct {
+	Version   uint64         `json:"version"`
+	Timestamp CephTimestamp  `json:"timestamp"`
+	Name      string         `json:"name"`
+	Changes   []ConfigChange `json:"changes"`
+}
+
+// ConfigChange is a single parameter change. Name is in "<who>/<param name>" format.
+type ConfigChange struct {
+	Name          string  `json:"name"`
+	PreviousValue *string `json:"previous_value,omitempty"`
+	NewValue      *string `json:"new_value,omitempty"`
+}
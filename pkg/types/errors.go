@@ -5,12 +5,14 @@ import (
 )
 
 var (
-	ErrNotImplemented  = errors.New("NotImplemented")
-	ErrInvalidConfig   = errors.New("InvalidConfig")
-	ErrInvalidArg      = errors.New("InvalidArg")
-	ErrNotFound        = errors.New("NotFound")
-	ErrAlreadyExists   = errors.New("ErrAlreadyExists")
-	ErrInternal        = errors.New("InternalError")
-	ErrUnauthenticated = errors.New("Unauthenticated")
-	ErrAccessDenied    = errors.New("AccessDenied")
+	ErrNotImplemented     = errors.New("NotImplemented")
+	ErrInvalidConfig      = errors.New("InvalidConfig")
+	ErrInvalidArg         = errors.New("InvalidArg")
+	ErrNotFound           = errors.New("NotFound")
+	ErrAlreadyExists      = errors.New("ErrAlreadyExists")
+	ErrInternal           = errors.New("InternalError")
+	ErrUnauthenticated    = errors.New("Unauthenticated")
+	ErrAccessDenied       = errors.New("AccessDenied")
+	ErrFailedPrecondition = errors.New("FailedPrecondition")
+	ErrConflict           = errors.New("Conflict")
 )
@@ -0,0 +1,12 @@
+package types
+
+// LogEntry is a cluster log entry returned by "log last" command.
+type LogEntry struct {
+	Name     string        `json:"name"`
+	Rank     string        `json:"rank"`
+	Stamp    CephTimestamp `json:"stamp"`
+	Seq      int64         `json:"seq"`
+	Channel  string        `json:"channel"`
+	Priority string        `json:"priority"`
+	Message  string        `json:"message"`
+}
@@ -0,0 +1,41 @@
+package user
+
+import "time"
+
+type Config struct {
+	// User password bcrypt cost. Passwords hashed with different cost are rehashed on login.
+	BcryptPwdCost int `yaml:"bcryptPwdCost"`
+	// Number of failed login attempts before account is disabled. Set 0 to disable lockout.
+	AccountLockoutAttempts int `yaml:"accountLockoutAttempts"`
+	// Password expiration period applied when password is set. Set 0 to disable expiration.
+	PwdExpirationSpan time.Duration `yaml:"pwdExpirationSpan"`
+	// Login response contains expiration warning level when password expires within given period.
+	PwdExpirationWarning1 time.Duration `yaml:"pwdExpirationWarning1"`
+	PwdExpirationWarning2 time.Duration `yaml:"pwdExpirationWarning2"`
+	PwdPolicy             PwdPolicy     `yaml:"pwdPolicy"`
+	// How often accessdb is checked for changes made by other ceph-api instances or ceph dashboard.
+	AccessDBPollInterval time.Duration `yaml:"accessDBPollInterval"`
+	// RADOS pool and namespace of object locked by ceph-api instances while accessdb is written. Pool is required.
+	AccessDBLockPool      string `yaml:"accessDBLockPool"`
+	AccessDBLockNamespace string `yaml:"accessDBLockNamespace"`
+}
+
+// PwdPolicy - password strength rules. Follows Ceph dashboard PWD_POLICY_* options.
+type PwdPolicy struct {
+	Enabled bool `yaml:"enabled"`
+	// Min password length. Set 0 to disable check.
+	MinLength int `yaml:"minLength"`
+	// Min password complexity credit. Set 0 to disable check.
+	// Each char gives credit: digit and lowercase letter - 1, uppercase letter - 2, special char - 3, other - 5.
+	MinComplexity int `yaml:"minComplexity"`
+	// Reject new password equal to the old one on password change.
+	CheckOldPwd bool `yaml:"checkOldPwd"`
+	// Reject password containing username.
+	CheckUsername bool `yaml:"checkUsername"`
+	// Reject password with 3 or more sequential chars, e.g. "abc", "123".
+	CheckSequentialChars bool `yaml:"checkSequentialChars"`
+	// Reject password with 3 or more repeated chars, e.g. "aaa".
+	CheckRepetitiveChars bool `yaml:"checkRepetitiveChars"`
+	// Reject password containing any of given words. Case-insensitive.
+	ExclusionList []string `yaml:"exclusionList"`
+}
@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// PermissionGrant is effective permission with roles granting it.
+type PermissionGrant struct {
+	Scope      string
+	Permission string
+	// Resource name glob of role resource permission. Empty if permission is granted for the whole scope.
+	Resource string
+	Roles    []string
+}
+
+// PermissionDecision explains permission check result.
+type PermissionDecision struct {
+	Allowed bool
+	Reason  string
+	// Grants of requested permission. Grants matching resource if resource was requested.
+	GrantedBy []PermissionGrant
+}
+
+// GetEffectivePermissions returns roles of user or service account and permissions granted by them.
+func (s *Service) GetEffectivePermissions(ctx context.Context, name string) ([]string, []PermissionGrant, error) {
+	s.RLock()
+	defer s.RUnlock()
+	var roles []string
+	if usr, ok := s.users[name]; ok {
+		roles = usr.Roles
+	} else if account, ok := s.serviceAccounts[name]; ok {
+		roles = account.Roles
+	} else {
+		return nil, nil, types.ErrNotFound
+	}
+	return roles, s.rolesGrants(roles), nil
+}
+
+// CanI explains if caller is granted permission for scope or for its resource, if resource is set.
+// Decision is made with permissions from context and explained with caller roles.
+func (s *Service) CanI(ctx context.Context, scope Scope, permission, resource string) (PermissionDecision, error) {
+	if _, ok := scopeSet[string(scope)]; !ok {
+		return PermissionDecision{}, fmt.Errorf("%w: unknown scope %s, valid values: %+q", types.ErrInvalidArg, scope, scopeSet)
+	}
+	perm, ok := permissionSet[permission]
+	if !ok {
+		return PermissionDecision{}, fmt.Errorf("%w: unknown permission %q, valid values %+q", types.ErrInvalidArg, permission, permissionList)
+	}
+	var err error
+	if resource == "" {
+		err = HasPermissions(ctx, scope, perm)
+	} else {
+		err = HasResourcePermissions(ctx, scope, resource, perm)
+	}
+	roles := xctx.GetRoles(ctx)
+	s.RLock()
+	grants := s.rolesGrants(roles)
+	s.RUnlock()
+
+	var matched, other []PermissionGrant
+	for _, g := range grants {
+		if g.Scope != string(scope) || g.Permission != permission {
+			continue
+		}
+		if g.Resource == "" || (resource != "" && globMatch(g.Resource, resource)) {
+			matched = append(matched, g)
+		} else {
+			other = append(other, g)
+		}
+	}
+	res := PermissionDecision{Allowed: err == nil, GrantedBy: matched}
+	rule := string(scope) + ":" + permission
+	switch {
+	case res.Allowed:
+		res.Reason = fmt.Sprintf("%s is granted by roles: %s", rule, strings.Join(grantRoles(matched), ", "))
+	case len(roles) == 0:
+		res.Reason = fmt.Sprintf("%s is denied: caller has no roles", rule)
+	case len(other) != 0:
+		resources := make([]string, len(other))
+		for i, g := range other {
+			resources[i] = g.Resource
+		}
+		res.Reason = fmt.Sprintf("%s is denied: it is granted only for resources %s by roles: %s", rule, strings.Join(resources, ", "), strings.Join(grantRoles(other), ", "))
+	default:
+		res.Reason = fmt.Sprintf("%s is denied: not granted by any of roles: %s", rule, strings.Join(roles, ", "))
+	}
+	return res, nil
+}
+
+// rolesGrants returns permissions granted by roles. Caller must hold read lock.
+func (s *Service) rolesGrants(roles []string) []PermissionGrant {
+	type grantKey struct{ scope, perm, resource string }
+	byKey := map[grantKey][]string{}
+	add := func(role string, key grantKey) {
+		if !slices.Contains(byKey[key], role) {
+			byKey[key] = append(byKey[key], role)
+		}
+	}
+	for _, roleName := range roles {
+		role, ok := systemRoleMap[roleName]
+		if !ok {
+			role = s.roles[roleName]
+		}
+		for scope, perms := range role.Permissions {
+			for _, p := range perms {
+				add(roleName, grantKey{scope: scope, perm: p})
+			}
+		}
+		for _, rp := range role.ResourcePermissions {
+			for _, r := range rp.Resources {
+				for _, p := range rp.Permissions {
+					add(roleName, grantKey{scope: rp.Scope, perm: p, resource: r})
+				}
+			}
+		}
+	}
+	res := make([]PermissionGrant, 0, len(byKey))
+	for k, v := range byKey {
+		res = append(res, PermissionGrant{Scope: k.scope, Permission: k.perm, Resource: k.resource, Roles: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Scope != res[j].Scope {
+			return res[i].Scope < res[j].Scope
+		}
+		if res[i].Permission != res[j].Permission {
+			return res[i].Permission < res[j].Permission
+		}
+		return res[i].Resource < res[j].Resource
+	})
+	return res
+}
+
+func grantRoles(grants []PermissionGrant) []string {
+	var res []string
+	for _, g := range grants {
+		for _, r := range g.Roles {
+			if !slices.Contains(res, r) {
+				res = append(res, r)
+			}
+		}
+	}
+	return res
+}
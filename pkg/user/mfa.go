@@ -0,0 +1,262 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/rs/zerolog"
+)
+
+const (
+	totpIssuer        = "ceph-api"
+	totpPeriod        = 30
+	totpSkew          = 1
+	recoveryCodesNum  = 10
+	recoveryCodeBytes = 5
+)
+
+var (
+	// ErrMFARequired returned when user has MFA enabled and second factor was not provided.
+	ErrMFARequired = errors.New("second factor required")
+	// ErrMFAInvalid returned when provided second factor code is not valid.
+	ErrMFAInvalid = errors.New("invalid second factor code")
+)
+
+// MFA is TOTP multi-factor authentication state of user.
+// TOTP secret is stored after enrollment, but second factor is required only after it was activated with valid code.
+type MFA struct {
+	TOTPSecret string `json:"totpSecret"`
+	Enabled    bool   `json:"enabled"`
+	// SHA-256 hashes of unused recovery codes
+	RecoveryCodes []string `json:"recoveryCodes"`
+	// last accepted TOTP time step, to prevent code reuse
+	LastTOTPStep int64 `json:"lastTotpStep"`
+}
+
+func (u *User) MFAEnabled() bool {
+	return u.MFA != nil && u.MFA.Enabled
+}
+
+// EnrollTOTP generates new TOTP secret for user. Returns secret and otpauth:// URI for authenticator apps.
+// Enrollment must be activated with ActivateTOTP.
+func (s *Service) EnrollTOTP(ctx context.Context, username string) (string, string, error) {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return "", "", types.ErrNotFound
+	}
+	if usr.MFAEnabled() {
+		return "", "", fmt.Errorf("%w: totp already enabled", types.ErrAlreadyExists)
+	}
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: username,
+		Period:      totpPeriod,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	usr.MFA = &MFA{TOTPSecret: key.Secret()}
+	if err = s.storeUser(ctx, usr); err != nil {
+		return "", "", err
+	}
+	return key.Secret(), key.URL(), nil
+}
+
+// ActivateTOTP enables second factor for user after verifying code generated with enrolled secret.
+// Returns recovery codes which can be used instead of TOTP code once.
+func (s *Service) ActivateTOTP(ctx context.Context, username, code string) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	if usr.MFA == nil || usr.MFA.TOTPSecret == "" {
+		return nil, fmt.Errorf("%w: totp is not enrolled", types.ErrFailedPrecondition)
+	}
+	if usr.MFA.Enabled {
+		return nil, fmt.Errorf("%w: totp already enabled", types.ErrAlreadyExists)
+	}
+	mfa := *usr.MFA
+	step, ok := validateTOTP(mfa.TOTPSecret, code, s.now())
+	if !ok {
+		return nil, fmt.Errorf("%w: %w", types.ErrInvalidArg, ErrMFAInvalid)
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	mfa.Enabled = true
+	mfa.LastTOTPStep = step
+	mfa.RecoveryCodes = hashes
+	usr.MFA = &mfa
+	if err = s.storeUser(ctx, usr); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces user recovery codes after verifying second factor.
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, username, code string) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	if !usr.MFAEnabled() {
+		return nil, fmt.Errorf("%w: totp is not enabled", types.ErrFailedPrecondition)
+	}
+	mfa, err := verifyMFA(*usr.MFA, code, s.now())
+	if err != nil {
+		return nil, err
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	mfa.RecoveryCodes = hashes
+	usr.MFA = &mfa
+	if err = s.storeUser(ctx, usr); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTOTP removes second factor of user. Code is verified only if verifyCode is set,
+// so administrator can reset MFA of user who lost authenticator device.
+func (s *Service) DisableTOTP(ctx context.Context, username, code string, verifyCode bool) error {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return types.ErrNotFound
+	}
+	if usr.MFA == nil {
+		return nil
+	}
+	if verifyCode && usr.MFA.Enabled {
+		if _, err := verifyMFA(*usr.MFA, code, s.now()); err != nil {
+			return err
+		}
+	}
+	usr.MFA = nil
+	return s.storeUser(ctx, usr)
+}
+
+// VerifyMFA checks second factor of user with enabled MFA. Code can be either TOTP code or unused recovery code.
+// Returns nil if MFA is not enabled for user.
+func (s *Service) VerifyMFA(ctx context.Context, username, code string) error {
+	s.Lock()
+	defer s.Unlock()
+	usr, ok := s.users[username]
+	if !ok {
+		return types.ErrNotFound
+	}
+	if !usr.MFAEnabled() {
+		return nil
+	}
+	// code is verified against the latest accessdb, so TOTP step or recovery code can't be reused on other instance
+	err := s.storeChange(ctx, func() (bool, error) {
+		usr, ok := s.users[username]
+		if !ok {
+			return false, types.ErrNotFound
+		}
+		if !usr.MFAEnabled() {
+			return false, nil
+		}
+		mfa, err := verifyMFA(*usr.MFA, code, s.now())
+		if err != nil {
+			return false, err
+		}
+		usr.MFA = &mfa
+		s.users[username] = usr
+		return true, nil
+	})
+	if errors.Is(err, ErrMFAInvalid) {
+		s.recordFailedLoginLocked(ctx, username)
+	}
+	return err
+}
+
+// verifyMFA returns updated MFA state with consumed TOTP step or recovery code.
+func verifyMFA(mfa MFA, code string, now time.Time) (MFA, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return mfa, fmt.Errorf("%w: %w", types.ErrUnauthenticated, ErrMFARequired)
+	}
+	if step, ok := validateTOTP(mfa.TOTPSecret, code, now); ok && step > mfa.LastTOTPStep {
+		mfa.LastTOTPStep = step
+		return mfa, nil
+	}
+	hash := hashRecoveryCode(code)
+	idx := slices.IndexFunc(mfa.RecoveryCodes, func(h string) bool {
+		return subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1
+	})
+	if idx < 0 {
+		return mfa, fmt.Errorf("%w: %w", types.ErrUnauthenticated, ErrMFAInvalid)
+	}
+	mfa.RecoveryCodes = slices.Delete(slices.Clone(mfa.RecoveryCodes), idx, idx+1)
+	return mfa, nil
+}
+
+// validateTOTP returns time step of matched code. Codes from adjacent steps are accepted to tolerate clock skew.
+func validateTOTP(secret, code string, now time.Time) (int64, bool) {
+	opts := totp.ValidateOpts{Period: totpPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1}
+	for i := -totpSkew; i <= totpSkew; i++ {
+		t := now.Add(time.Duration(i*totpPeriod) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret, t, opts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return t.Unix() / totpPeriod, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCodes returns recovery codes and their hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodesNum)
+	hashes := make([]string, recoveryCodesNum)
+	for i := range codes {
+		code, err := randomHex(recoveryCodeBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes[i] = code[:len(code)/2] + "-" + code[len(code)/2:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	return sha256Hex(strings.ToLower(strings.ReplaceAll(code, "-", "")))
+}
+
+// storeUser saves user to accessdb. Caller must hold write lock.
+// User version is changed, so UpdateUser with version loaded before can't revert the change.
+func (s *Service) storeUser(ctx context.Context, usr User) error {
+	usr.LastUpdate = nextUpdate(usr.LastUpdate)
+	s.users[usr.Username] = usr
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return err
+	}
+	return nil
+}
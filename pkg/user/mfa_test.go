@@ -0,0 +1,154 @@
+//go:build mock
+
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// mfaTestTime is fixed clock in the middle of TOTP period.
+var mfaTestTime = time.Unix(1700000015, 0)
+
+func newMFATestService(t *testing.T, username string) (*Service, string, []string) {
+	t.Helper()
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+	s, err := New(radosSvc, Config{BcryptPwdCost: 4, AccessDBLockPool: ".mgr", AccountLockoutAttempts: 5})
+	r.NoError(err)
+	s.now = func() time.Time { return mfaTestTime }
+
+	r.NoError(s.CreateUser(ctx, User{Username: username, Password: "mfa-pass", Roles: []string{"read-only"}, Enabled: true}))
+	secret, _, err := s.EnrollTOTP(ctx, username)
+	r.NoError(err)
+	codes, err := s.ActivateTOTP(ctx, username, totpCode(t, secret, mfaTestTime))
+	r.NoError(err)
+	r.Len(codes, recoveryCodesNum)
+	return s, secret, codes
+}
+
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := totp.GenerateCode(secret, at)
+	require.NoError(t, err)
+	return code
+}
+
+func TestValidateTOTP_Skew(t *testing.T) {
+	secret := "JBSWY3DPEHPK3PXP"
+	step := mfaTestTime.Unix() / totpPeriod
+	tests := []struct {
+		name     string
+		codeAt   time.Duration
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", codeAt: 0, wantOK: true, wantStep: step},
+		{name: "previous step", codeAt: -totpPeriod * time.Second, wantOK: true, wantStep: step - 1},
+		{name: "next step", codeAt: totpPeriod * time.Second, wantOK: true, wantStep: step + 1},
+		{name: "two steps ago", codeAt: -2 * totpPeriod * time.Second},
+		{name: "two steps ahead", codeAt: 2 * totpPeriod * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, ok := validateTOTP(secret, totpCode(t, secret, mfaTestTime.Add(tt.codeAt)), mfaTestTime)
+			require.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				require.Equal(t, tt.wantStep, gotStep)
+			}
+		})
+	}
+	_, ok := validateTOTP(secret, "", mfaTestTime)
+	require.False(t, ok)
+}
+
+func TestService_VerifyMFA_StepReuse(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s, secret, _ := newMFATestService(t, "mfa-user")
+
+	// code used for activation cannot be reused
+	err := s.VerifyMFA(ctx, "mfa-user", totpCode(t, secret, mfaTestTime))
+	r.ErrorIs(err, ErrMFAInvalid)
+	r.ErrorIs(err, types.ErrUnauthenticated)
+
+	// code of the next step is accepted within skew window, but only once
+	next := totpCode(t, secret, mfaTestTime.Add(totpPeriod*time.Second))
+	r.NoError(s.VerifyMFA(ctx, "mfa-user", next))
+	r.ErrorIs(s.VerifyMFA(ctx, "mfa-user", next), ErrMFAInvalid)
+	// code of older step is rejected after newer one was used
+	r.ErrorIs(s.VerifyMFA(ctx, "mfa-user", totpCode(t, secret, mfaTestTime.Add(-totpPeriod*time.Second))), ErrMFAInvalid)
+
+	// the same code is accepted when clock moves to the next step
+	s.now = func() time.Time { return mfaTestTime.Add(2 * totpPeriod * time.Second) }
+	r.NoError(s.VerifyMFA(ctx, "mfa-user", totpCode(t, secret, s.now())))
+
+	r.ErrorIs(s.VerifyMFA(ctx, "mfa-user", ""), ErrMFARequired)
+
+	// invalid codes are counted as failed login attempts
+	usr, err := s.GetUser(ctx, "mfa-user")
+	r.NoError(err)
+	r.Equal(3, usr.InvalidAuthAttempt)
+}
+
+func TestService_VerifyMFA_RecoveryCode(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s, _, codes := newMFATestService(t, "mfa-user")
+
+	r.NoError(s.VerifyMFA(ctx, "mfa-user", codes[0]))
+	r.ErrorIs(s.VerifyMFA(ctx, "mfa-user", codes[0]), ErrMFAInvalid, "recovery code is one-time")
+	// recovery code is case-insensitive and dash is optional
+	r.NoError(s.VerifyMFA(ctx, "mfa-user", " "+strings.ToUpper(strings.ReplaceAll(codes[1], "-", ""))+" "))
+
+	usr, err := s.GetUser(ctx, "mfa-user")
+	r.NoError(err)
+	r.Len(usr.MFA.RecoveryCodes, recoveryCodesNum-2)
+
+	// user without MFA does not need second factor
+	r.NoError(s.CreateUser(ctx, User{Username: "no-mfa", Password: "no-mfa-pass", Roles: []string{"read-only"}, Enabled: true}))
+	r.NoError(s.VerifyMFA(ctx, "no-mfa", ""))
+	r.ErrorIs(s.VerifyMFA(ctx, "unknown", ""), types.ErrNotFound)
+}
+
+func TestService_DisableTOTP(t *testing.T) {
+	ctx := context.Background()
+	t.Run("with code", func(t *testing.T) {
+		r := require.New(t)
+		s, secret, _ := newMFATestService(t, "mfa-user")
+		r.ErrorIs(s.DisableTOTP(ctx, "mfa-user", "", true), ErrMFARequired)
+		r.ErrorIs(s.DisableTOTP(ctx, "mfa-user", "000000", true), ErrMFAInvalid)
+		usr, err := s.GetUser(ctx, "mfa-user")
+		r.NoError(err)
+		r.True(usr.MFAEnabled())
+
+		prev := usr
+		r.NoError(s.DisableTOTP(ctx, "mfa-user", totpCode(t, secret, mfaTestTime.Add(totpPeriod*time.Second)), true))
+		usr, err = s.GetUser(ctx, "mfa-user")
+		r.NoError(err)
+		r.Nil(usr.MFA)
+		r.NotEqual(UserVersion(prev), UserVersion(usr), "MFA change updates user version")
+		r.NoError(s.VerifyMFA(ctx, "mfa-user", ""))
+	})
+	t.Run("without code", func(t *testing.T) {
+		r := require.New(t)
+		s, _, _ := newMFATestService(t, "mfa-user")
+		// administrator resets MFA of user who lost authenticator
+		r.NoError(s.DisableTOTP(ctx, "mfa-user", "", false))
+		usr, err := s.GetUser(ctx, "mfa-user")
+		r.NoError(err)
+		r.Nil(usr.MFA)
+		r.ErrorIs(s.DisableTOTP(ctx, "unknown", "", false), types.ErrNotFound)
+	})
+}
@@ -0,0 +1,235 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password expiration warning levels returned by PwdExpirationWarning.
+const (
+	PwdExpirationNoWarning = iota
+	PwdExpirationWarning1
+	PwdExpirationWarning2
+)
+
+// Check returns error describing all policy rules violated by password.
+func (p *PwdPolicy) Check(username, password string) error {
+	if !p.Enabled {
+		return nil
+	}
+	var errs []error
+	if p.MinLength > 0 && len([]rune(password)) < p.MinLength {
+		errs = append(errs, fmt.Errorf("password must be at least %d characters long", p.MinLength))
+	}
+	if p.MinComplexity > 0 && pwdComplexity(password) < p.MinComplexity {
+		errs = append(errs, errors.New("password is too weak"))
+	}
+	lower := strings.ToLower(password)
+	if p.CheckUsername && username != "" && strings.Contains(lower, strings.ToLower(username)) {
+		errs = append(errs, errors.New("password must not contain username"))
+	}
+	for _, word := range p.ExclusionList {
+		if word != "" && strings.Contains(lower, strings.ToLower(word)) {
+			errs = append(errs, fmt.Errorf("password must not contain %q", word))
+			break
+		}
+	}
+	runes := []rune(password)
+	if p.CheckSequentialChars && hasCharRun(runes, func(a, b rune) bool { return a+1 == b }) {
+		errs = append(errs, errors.New("password must not contain sequential characters"))
+	}
+	if p.CheckRepetitiveChars && hasCharRun(runes, func(a, b rune) bool { return a == b }) {
+		errs = append(errs, errors.New("password must not contain repetitive characters"))
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%w: %w", types.ErrInvalidArg, errors.Join(errs...))
+	}
+	return nil
+}
+
+// hasCharRun returns true if password contains 3 consecutive chars where each pair matches next func.
+func hasCharRun(runes []rune, next func(a, b rune) bool) bool {
+	for i := 2; i < len(runes); i++ {
+		if next(runes[i-2], runes[i-1]) && next(runes[i-1], runes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// pwdComplexity calculates password complexity credit the same way as Ceph dashboard.
+func pwdComplexity(password string) int {
+	credit := 0
+	for _, r := range password {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			credit += 2
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			credit++
+		case r < unicode.MaxASCII && (unicode.IsPunct(r) || unicode.IsSymbol(r)):
+			credit += 3
+		default:
+			credit += 5
+		}
+	}
+	return credit
+}
+
+// setPassword validates password against policy and sets its hash to user.
+// Sets password expiration date if it is not set and expiration span is configured.
+func (s *Service) setPassword(usr *User, password string) error {
+	if err := s.conf.PwdPolicy.Check(usr.Username, password); err != nil {
+		return err
+	}
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte(password), s.conf.BcryptPwdCost)
+	if err != nil {
+		return err
+	}
+	usr.Password = string(pwdHash)
+	if s.conf.PwdExpirationSpan > 0 && usr.PwdExpirationDate == nil {
+		expiration := int(time.Now().Add(s.conf.PwdExpirationSpan).Unix())
+		usr.PwdExpirationDate = &expiration
+	}
+	return nil
+}
+
+// VerifyPassword checks password of local user. Failed attempts are counted and user is disabled
+// when AccountLockoutAttempts is reached. Password hash is upgraded if configured bcrypt cost was changed.
+func (s *Service) VerifyPassword(ctx context.Context, username, password string) error {
+	s.RLock()
+	usr, ok := s.users[username]
+	s.RUnlock()
+	if !ok {
+		return types.ErrNotFound
+	}
+	if !usr.Enabled {
+		return fmt.Errorf("%w: user disabled", types.ErrUnauthenticated)
+	}
+	if usr.Password == "" {
+		return fmt.Errorf("%w: user is managed by external identity provider", types.ErrUnauthenticated)
+	}
+	// compare outside of lock, because bcrypt is slow
+	if err := bcrypt.CompareHashAndPassword([]byte(usr.Password), []byte(password)); err != nil {
+		s.recordFailedLogin(ctx, username)
+		return fmt.Errorf("%w: invalid credentials", types.ErrUnauthenticated)
+	}
+	if usr.PwdExpirationDate != nil && int64(*usr.PwdExpirationDate) <= time.Now().Unix() {
+		return fmt.Errorf("%w: password expired", types.ErrUnauthenticated)
+	}
+	if cost, err := bcrypt.Cost([]byte(usr.Password)); err == nil && cost != s.bcryptCost() {
+		s.rehashPassword(ctx, usr, password)
+	}
+	return nil
+}
+
+func (s *Service) bcryptCost() int {
+	if s.conf.BcryptPwdCost < bcrypt.MinCost {
+		// the same as bcrypt.GenerateFromPassword does
+		return bcrypt.DefaultCost
+	}
+	return s.conf.BcryptPwdCost
+}
+
+// rehashPassword stores password hashed with configured cost. Errors are logged, because they should not fail login.
+func (s *Service) rehashPassword(ctx context.Context, prev User, password string) {
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte(password), s.conf.BcryptPwdCost)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", prev.Username).Msg("unable to rehash password")
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	err = s.storeChange(ctx, func() (bool, error) {
+		usr, ok := s.users[prev.Username]
+		// skip if password was changed concurrently
+		if !ok || usr.Password != prev.Password {
+			return false, nil
+		}
+		usr.Password = string(pwdHash)
+		s.users[usr.Username] = usr
+		return true, nil
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", prev.Username).Msg("unable to store rehashed password")
+	}
+}
+
+// recordFailedLogin increments failed login attempts of user and disables user if lockout limit is reached.
+func (s *Service) recordFailedLogin(ctx context.Context, username string) {
+	s.Lock()
+	defer s.Unlock()
+	s.recordFailedLoginLocked(ctx, username)
+}
+
+// recordFailedLoginLocked - same as recordFailedLogin. Caller must hold write lock.
+func (s *Service) recordFailedLoginLocked(ctx context.Context, username string) {
+	if s.conf.AccountLockoutAttempts <= 0 {
+		return
+	}
+	err := s.storeChange(ctx, func() (bool, error) {
+		usr, ok := s.users[username]
+		if !ok || !usr.Enabled {
+			return false, nil
+		}
+		usr.InvalidAuthAttempt++
+		if usr.InvalidAuthAttempt >= s.conf.AccountLockoutAttempts {
+			usr.Enabled = false
+			// change user version, so UpdateUser with version loaded before lockout can't enable user
+			usr.LastUpdate = nextUpdate(usr.LastUpdate)
+			zerolog.Ctx(ctx).Warn().Str("username", username).Int("attempts", usr.InvalidAuthAttempt).Msg("user locked after too many failed login attempts")
+		}
+		s.users[username] = usr
+		return true, nil
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("unable to store failed login attempt")
+	}
+}
+
+// ResetFailedLogins resets failed login attempts counter after successful login.
+func (s *Service) ResetFailedLogins(ctx context.Context, username string) {
+	s.RLock()
+	usr, ok := s.users[username]
+	s.RUnlock()
+	if !ok || usr.InvalidAuthAttempt == 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	err := s.storeChange(ctx, func() (bool, error) {
+		usr, ok := s.users[username]
+		if !ok || usr.InvalidAuthAttempt == 0 {
+			return false, nil
+		}
+		usr.InvalidAuthAttempt = 0
+		s.users[username] = usr
+		return true, nil
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("username", username).Msg("unable to reset failed login attempts")
+	}
+}
+
+// PwdExpirationWarning returns warning level if user password expires soon.
+func (s *Service) PwdExpirationWarning(usr User) int {
+	if usr.PwdExpirationDate == nil {
+		return PwdExpirationNoWarning
+	}
+	expiresIn := time.Until(time.Unix(int64(*usr.PwdExpirationDate), 0))
+	switch {
+	case s.conf.PwdExpirationWarning2 > 0 && expiresIn <= s.conf.PwdExpirationWarning2:
+		return PwdExpirationWarning2
+	case s.conf.PwdExpirationWarning1 > 0 && expiresIn <= s.conf.PwdExpirationWarning1:
+		return PwdExpirationWarning1
+	default:
+		return PwdExpirationNoWarning
+	}
+}
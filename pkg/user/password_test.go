@@ -0,0 +1,186 @@
+//go:build mock
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPwdPolicy_Check(t *testing.T) {
+	policy := PwdPolicy{
+		Enabled:              true,
+		MinLength:            8,
+		MinComplexity:        10,
+		CheckUsername:        true,
+		CheckSequentialChars: true,
+		CheckRepetitiveChars: true,
+		ExclusionList:        []string{"ceph", "osd"},
+	}
+	tests := []struct {
+		name     string
+		policy   PwdPolicy
+		username string
+		password string
+		wantErr  string
+	}{
+		{name: "valid", policy: policy, username: "admin", password: "Str0ng-Pw!"},
+		{name: "disabled policy", policy: PwdPolicy{MinLength: 100}, username: "admin", password: "a"},
+		{name: "too short", policy: policy, username: "admin", password: "Sh0r!", wantErr: "at least 8 characters"},
+		{name: "length in runes", policy: PwdPolicy{Enabled: true, MinLength: 4}, password: "пароль"},
+		{name: "too weak", policy: policy, username: "admin", password: "qwertzui", wantErr: "too weak"},
+		{name: "contains username", policy: policy, username: "Admin", password: "My-ADMIN-Pw!", wantErr: "must not contain username"},
+		{name: "excluded word", policy: policy, username: "admin", password: "My-Ceph-Pw!", wantErr: `must not contain "ceph"`},
+		{name: "sequential chars", policy: policy, username: "admin", password: "Pw!-xyz-Pw!", wantErr: "sequential"},
+		{name: "repetitive chars", policy: policy, username: "admin", password: "Pw!-zzz-Pw!", wantErr: "repetitive"},
+		{name: "all violations reported", policy: policy, username: "admin", password: "aaa", wantErr: "at least 8 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(tt.username, tt.password)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidArg)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+	err := policy.Check("admin", "aaa")
+	require.ErrorContains(t, err, "too weak")
+	require.ErrorContains(t, err, "repetitive")
+}
+
+func TestPwdComplexity(t *testing.T) {
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{password: "", want: 0},
+		{password: "abc123", want: 6},
+		{password: "ABC", want: 6},
+		{password: "!@#", want: 9},
+		{password: "ä", want: 5},
+		{password: "Aa1!ä", want: 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			require.Equal(t, tt.want, pwdComplexity(tt.password))
+		})
+	}
+}
+
+func TestHasCharRun(t *testing.T) {
+	sequential := func(a, b rune) bool { return a+1 == b }
+	repetitive := func(a, b rune) bool { return a == b }
+	tests := []struct {
+		name     string
+		password string
+		next     func(a, b rune) bool
+		want     bool
+	}{
+		{name: "empty", password: "", next: sequential},
+		{name: "two sequential", password: "ab-xy", next: sequential},
+		{name: "three sequential", password: "x-abc", next: sequential, want: true},
+		{name: "sequential digits", password: "pw789", next: sequential, want: true},
+		{name: "descending", password: "cba", next: sequential},
+		{name: "two repetitive", password: "aabb", next: repetitive},
+		{name: "three repetitive", password: "abbb", next: repetitive, want: true},
+		{name: "non ascii", password: "жжж", next: repetitive, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, hasCharRun([]rune(tt.password), tt.next))
+		})
+	}
+}
+
+func newPwdTestService(t *testing.T, conf Config) *Service {
+	t.Helper()
+	conn, err := rados.NewMockConn()
+	require.NoError(t, err)
+	radosSvc, err := rados.New(conn)
+	require.NoError(t, err)
+	s, err := New(radosSvc, conf)
+	require.NoError(t, err)
+	return s
+}
+
+func TestService_VerifyPassword_Lockout(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		attempts   int
+		failures   int
+		wantLocked bool
+	}{
+		{name: "below threshold", attempts: 3, failures: 2},
+		{name: "threshold reached", attempts: 3, failures: 3, wantLocked: true},
+		{name: "lockout disabled", attempts: 0, failures: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			s := newPwdTestService(t, Config{BcryptPwdCost: bcrypt.MinCost, AccessDBLockPool: ".mgr", AccountLockoutAttempts: tt.attempts})
+			r.NoError(s.CreateUser(ctx, User{Username: "user", Password: "user-pass", Roles: []string{"read-only"}, Enabled: true}))
+			prev, err := s.GetUser(ctx, "user")
+			r.NoError(err)
+			for i := 0; i < tt.failures; i++ {
+				r.ErrorIs(s.VerifyPassword(ctx, "user", "wrong"), types.ErrUnauthenticated)
+			}
+			usr, err := s.GetUser(ctx, "user")
+			r.NoError(err)
+			r.Equal(!tt.wantLocked, usr.Enabled)
+			err = s.VerifyPassword(ctx, "user", "user-pass")
+			if tt.wantLocked {
+				r.ErrorIs(err, types.ErrUnauthenticated, "locked user cannot log in with valid password")
+				prev.Password = ""
+				err = s.UpdateUser(ctx, prev, UserVersion(prev))
+				r.ErrorIs(err, types.ErrFailedPrecondition, "update loaded before lockout does not enable user")
+				return
+			}
+			r.NoError(err)
+			s.ResetFailedLogins(ctx, "user")
+			usr, err = s.GetUser(ctx, "user")
+			r.NoError(err)
+			r.Zero(usr.InvalidAuthAttempt)
+		})
+	}
+}
+
+func TestService_VerifyPassword_Rehash(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	s := newPwdTestService(t, Config{BcryptPwdCost: bcrypt.MinCost, AccessDBLockPool: ".mgr"})
+	r.NoError(s.CreateUser(ctx, User{Username: "user", Password: "user-pass", Roles: []string{"read-only"}, Enabled: true}))
+	usr, err := s.GetUser(ctx, "user")
+	r.NoError(err)
+	prevHash := usr.Password
+
+	// the same cost: hash is kept
+	r.NoError(s.VerifyPassword(ctx, "user", "user-pass"))
+	usr, err = s.GetUser(ctx, "user")
+	r.NoError(err)
+	r.Equal(prevHash, usr.Password)
+
+	// cost changed: hash is upgraded on successful login only
+	s.conf.BcryptPwdCost = bcrypt.MinCost + 1
+	r.Error(s.VerifyPassword(ctx, "user", "wrong"))
+	usr, err = s.GetUser(ctx, "user")
+	r.NoError(err)
+	r.Equal(prevHash, usr.Password)
+
+	r.NoError(s.VerifyPassword(ctx, "user", "user-pass"))
+	usr, err = s.GetUser(ctx, "user")
+	r.NoError(err)
+	r.NotEqual(prevHash, usr.Password)
+	cost, err := bcrypt.Cost([]byte(usr.Password))
+	r.NoError(err)
+	r.Equal(bcrypt.MinCost+1, cost)
+	r.NoError(s.VerifyPassword(ctx, "user", "user-pass"), "rehashed password is valid")
+}
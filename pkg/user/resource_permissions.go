@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+
+	xctx "github.com/clyso/ceph-api/pkg/ctx"
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// ResourcePermission grants scope permissions only for resources with names matching given globs,
+// e.g. pool permissions for pools "tenant-a-*". Glob syntax is the same as in path.Match.
+type ResourcePermission struct {
+	Scope       string   `json:"scope"`
+	Resources   []string `json:"resources"`
+	Permissions []string `json:"permissions"`
+}
+
+func (p *ResourcePermission) Validate() error {
+	if _, ok := scopeSet[p.Scope]; !ok {
+		return fmt.Errorf("%w: unknown scope %s, valid values: %+q", types.ErrInvalidArg, p.Scope, scopeSet)
+	}
+	if len(p.Resources) == 0 {
+		return fmt.Errorf("%w: resources required for %s resource permission", types.ErrInvalidArg, p.Scope)
+	}
+	for _, r := range p.Resources {
+		if _, err := path.Match(r, ""); r == "" || err != nil {
+			return fmt.Errorf("%w: invalid resource pattern %q", types.ErrInvalidArg, r)
+		}
+	}
+	for _, perm := range p.Permissions {
+		if _, ok := permissionSet[perm]; !ok {
+			return fmt.Errorf("%w: unknown permission %q, valid values %+q", types.ErrInvalidArg, perm, permissionList)
+		}
+	}
+	return nil
+}
+
+// HasResourcePermissions checks permissions for resource of given scope.
+// Permission is granted either for the whole scope or by role resource permission matching resource name.
+func HasResourcePermissions(ctx context.Context, scope Scope, resource string, perms ...Permission) error {
+	permissions := xctx.GetPermissions(ctx)
+	resourcePermissions := xctx.GetResourcePermissions(ctx)
+	if len(permissions) == 0 && len(resourcePermissions) == 0 {
+		return types.ErrAccessDenied
+	}
+	for _, p := range perms {
+		if slices.Contains(permissions[string(scope)], p.String()) {
+			continue
+		}
+		granted := false
+		for pattern, resPerms := range resourcePermissions[string(scope)] {
+			if globMatch(pattern, resource) && slices.Contains(resPerms, p.String()) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return types.ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// HasAnyResourcePermissions checks that permissions are granted for the whole scope or at least for some of its resources.
+// Used by list handlers, which filter results with HasResourcePermissions.
+func HasAnyResourcePermissions(ctx context.Context, scope Scope, perms ...Permission) error {
+	permissions := xctx.GetPermissions(ctx)
+	resourcePermissions := xctx.GetResourcePermissions(ctx)
+	for _, p := range perms {
+		if slices.Contains(permissions[string(scope)], p.String()) {
+			continue
+		}
+		granted := false
+		for _, resPerms := range resourcePermissions[string(scope)] {
+			if slices.Contains(resPerms, p.String()) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return types.ErrAccessDenied
+		}
+	}
+	return nil
+}
+
+// GetRolesResourcePermissions returns resource permissions granted by given roles.
+// Result maps scope to resource name glob to permissions.
+func (s *Service) GetRolesResourcePermissions(ctx context.Context, roles []string) map[string]map[string][]string {
+	s.RLock()
+	defer s.RUnlock()
+	res := map[string]map[string][]string{}
+	for _, role := range roles {
+		for _, rp := range s.roles[role].ResourcePermissions {
+			if res[rp.Scope] == nil {
+				res[rp.Scope] = map[string][]string{}
+			}
+			for _, r := range rp.Resources {
+				res[rp.Scope][r] = append(res[rp.Scope][r], rp.Permissions...)
+			}
+		}
+	}
+	for _, resources := range res {
+		for r, perms := range resources {
+			sort.Strings(perms)
+			resources[r] = slices.Compact(perms)
+		}
+	}
+	return res
+}
+
+func globMatch(pattern, name string) bool {
+	match, _ := path.Match(pattern, name)
+	return match
+}
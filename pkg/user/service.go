@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"slices"
 	"sort"
 	"sync"
@@ -18,9 +19,22 @@ import (
 )
 
 const (
-	pwdBcryptCost = 3
-	setDBMonCmd   = `{"prefix": "config-key set", "key": "mgr/dashboard/accessdb_v2"}`
-	getDBMonCmd   = `{"prefix": "config-key get", "key": "mgr/dashboard/accessdb_v2"}`
+	accessDBKey = "mgr/dashboard/accessdb_v2"
+
+	// SourceLDAP is identity source of users provisioned on LDAP login.
+	SourceLDAP = "ldap"
+
+	defaultAccessDBPollInterval = 10 * time.Second
+
+	accessDBLockObj  = "accessdb_lock"
+	accessDBLockName = "accessdb"
+	// lock is released on expiration if ceph-api instance crashed while writing accessdb
+	accessDBLockDuration = 30 * time.Second
+)
+
+var (
+	setDBMonCmd = rados.MonCmd{Prefix: "config-key set", Args: map[string]any{"key": accessDBKey}}
+	getDBMonCmd = rados.MonCmd{Prefix: "config-key get", Args: map[string]any{"key": accessDBKey}}
 )
 
 func HasPermissions(ctx context.Context, scope Scope, perms ...Permission) error {
@@ -36,8 +50,11 @@ func HasPermissions(ctx context.Context, scope Scope, perms ...Permission) error
 	return nil
 }
 
-func New(radosSvc *rados.Svc) (*Service, error) {
-	res := &Service{radosSvc: radosSvc}
+func New(radosSvc *rados.Svc, conf Config) (*Service, error) {
+	if conf.AccessDBLockPool == "" {
+		return nil, fmt.Errorf("%w: app accessDBLockPool is required to serialize accessdb writes", types.ErrInvalidConfig)
+	}
+	res := &Service{radosSvc: radosSvc, conf: conf, now: time.Now}
 	if err := res.updateFromDB(context.Background()); err != nil {
 		return nil, err
 	}
@@ -47,40 +64,178 @@ func New(radosSvc *rados.Svc) (*Service, error) {
 type Service struct {
 	sync.RWMutex
 	radosSvc *rados.Svc
+	conf     Config
 	users    map[string]User
 	roles    map[string]Role
+	// service accounts are stored in accessdb along with users
+	serviceAccounts map[string]ServiceAccount
+	// checksum of accessdb content loaded or stored by this instance. Empty if accessdb does not exist.
+	dbVersion string
+	// current time used to validate TOTP codes. Replaced in tests.
+	now func() time.Time
 }
 
+// updateFromDB replaces in-memory state with accessdb content. State is not changed on error.
 func (s *Service) updateFromDB(ctx context.Context) error {
-	s.users = map[string]User{}
-	s.roles = map[string]Role{}
-	cmdRes, err := s.radosSvc.ExecMon(ctx, getDBMonCmd)
+	raw, err := s.getDB(ctx)
 	if err != nil {
-		if errors.Is(err, types.RadosErrorNotFound) {
-			return nil
-		}
 		return err
 	}
+	return s.applyDB(raw)
+}
+
+// applyDB replaces in-memory state with raw accessdb content. State is not changed on error.
+func (s *Service) applyDB(raw []byte) error {
 	var res db
-	if err = json.Unmarshal(cmdRes, &res); err != nil {
-		return err
+	if raw != nil {
+		if err := json.Unmarshal(raw, &res); err != nil {
+			return err
+		}
+	}
+	s.users, s.roles, s.serviceAccounts = res.Users, res.Roles, res.ServiceAccounts
+	if s.users == nil {
+		s.users = map[string]User{}
 	}
-	if len(res.Users) != 0 {
-		s.users = res.Users
+	if s.roles == nil {
+		s.roles = map[string]Role{}
 	}
-	if len(res.Roles) != 0 {
-		s.roles = res.Roles
+	if s.serviceAccounts == nil {
+		s.serviceAccounts = map[string]ServiceAccount{}
 	}
+	s.dbVersion = dbVersion(raw)
 	return nil
 }
 
+// storeToDB writes in-memory state to accessdb. Write fails with ErrConflict if accessdb was changed
+// since it was loaded by this instance. Caller should rollback with updateFromDB to load the latest state.
 func (s *Service) storeToDB(ctx context.Context) error {
-	res, err := json.Marshal(&db{Users: s.users, Roles: s.roles, Version: 2})
+	// config-key has no conditional set, so check and write are done under lock
+	unlock, err := s.lockDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	current, err := s.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	if dbVersion(current) != s.dbVersion {
+		return fmt.Errorf("%w: users and roles were changed concurrently, retry the request", types.ErrConflict)
+	}
+	return s.writeDB(ctx)
+}
+
+// storeChange applies change to the latest accessdb content and writes it. Unlike storeToDB it does not fail
+// if accessdb was changed concurrently, so changes made on authentication, e.g. failed login attempts, are not lost.
+// Change returns false if there is nothing to write. Caller must hold write lock.
+func (s *Service) storeChange(ctx context.Context, change func() (bool, error)) error {
+	unlock, err := s.lockDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	current, err := s.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	if dbVersion(current) != s.dbVersion {
+		if err = s.applyDB(current); err != nil {
+			return err
+		}
+	}
+	changed, err := change()
+	if err != nil || !changed {
+		return err
+	}
+	if err = s.writeDB(ctx); err != nil {
+		//rollback changes
+		if rollbackErr := s.applyDB(current); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return err
+	}
+	return nil
+}
+
+// writeDB writes in-memory state to accessdb. Caller must hold accessdb lock.
+func (s *Service) writeDB(ctx context.Context) error {
+	res, err := json.Marshal(&db{Users: s.users, Roles: s.roles, ServiceAccounts: s.serviceAccounts, Version: 2})
 	if err != nil {
 		return err
 	}
 	_, err = s.radosSvc.ExecMonWithInputBuff(ctx, setDBMonCmd, res)
-	return err
+	if err != nil {
+		return err
+	}
+	// ceph dashboard writes accessdb without lock, so its write right after ours overwrites the change
+	current, err := s.getDB(ctx)
+	if err != nil {
+		return err
+	}
+	if dbVersion(current) != dbVersion(res) {
+		return fmt.Errorf("%w: users and roles were changed concurrently by ceph dashboard, retry the request", types.ErrConflict)
+	}
+	s.dbVersion = dbVersion(res)
+	return nil
+}
+
+// lockDB takes accessdb lock shared by ceph-api instances.
+func (s *Service) lockDB(ctx context.Context) (func(), error) {
+	return s.radosSvc.LockExclusive(ctx, s.conf.AccessDBLockPool, s.conf.AccessDBLockNamespace, accessDBLockObj, accessDBLockName, accessDBLockDuration)
+}
+
+// getDB returns raw accessdb content or nil if accessdb does not exist.
+func (s *Service) getDB(ctx context.Context) ([]byte, error) {
+	cmdRes, err := s.radosSvc.ExecMon(ctx, getDBMonCmd)
+	if errors.Is(err, types.RadosErrorNotFound) {
+		return nil, nil
+	}
+	return cmdRes, err
+}
+
+func dbVersion(raw []byte) string {
+	if raw == nil {
+		return ""
+	}
+	return sha256Hex(string(raw))
+}
+
+// WatchDB reloads users and roles when accessdb is changed by other ceph-api instance or ceph dashboard.
+// Blocks until context is cancelled.
+func (s *Service) WatchDB(ctx context.Context) error {
+	interval := s.conf.AccessDBPollInterval
+	if interval <= 0 {
+		interval = defaultAccessDBPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		raw, err := s.getDB(ctx)
+		if err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to check accessdb for changes")
+			continue
+		}
+		version := dbVersion(raw)
+		s.RLock()
+		changed := version != s.dbVersion
+		s.RUnlock()
+		if !changed {
+			continue
+		}
+		s.Lock()
+		err = s.updateFromDB(ctx)
+		s.Unlock()
+		if err != nil {
+			zerolog.Ctx(ctx).Err(err).Msg("unable to reload changed accessdb")
+			continue
+		}
+		zerolog.Ctx(ctx).Info().Msg("accessdb changed, users and roles reloaded")
+	}
 }
 
 func (s *Service) ListUsers(ctx context.Context) ([]User, error) {
@@ -109,12 +264,24 @@ func (s *Service) GetUser(ctx context.Context, username string) (User, error) {
 func (s *Service) GetPermissions(ctx context.Context, username string) map[string][]string {
 	s.RLock()
 	defer s.RUnlock()
-	res := map[string][]string{}
 	usr, ok := s.users[username]
 	if !ok {
-		return res
+		return map[string][]string{}
 	}
-	for _, role := range usr.Roles {
+	return s.rolesPermissions(usr.Roles)
+}
+
+// GetRolesPermissions returns permissions granted by given roles.
+// Used for users not stored in accessdb, e.g. federated SSO users.
+func (s *Service) GetRolesPermissions(ctx context.Context, roles []string) map[string][]string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.rolesPermissions(roles)
+}
+
+func (s *Service) rolesPermissions(roles []string) map[string][]string {
+	res := map[string][]string{}
+	for _, role := range roles {
 		for scope, perm := range s.roles[role].Permissions {
 			res[scope] = append(res[scope], perm...)
 		}
@@ -129,27 +296,37 @@ func (s *Service) GetPermissions(ctx context.Context, username string) map[strin
 	return res
 }
 
-func (s *Service) UpdateUser(ctx context.Context, user User) error {
+// UpdateUser replaces user. If version is set, update is rejected when user was changed since given version was read.
+func (s *Service) UpdateUser(ctx context.Context, user User, version string) error {
 	s.Lock()
 	defer s.Unlock()
 	prev, ok := s.users[user.Username]
 	if !ok {
 		return types.ErrNotFound
 	}
+	if err := checkVersion("user", user.Username, UserVersion(prev), version); err != nil {
+		return err
+	}
 	if err := s.validateUseRoles(user); err != nil {
 		return err
 	}
 	if user.Password != "" {
-		pwdHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), pwdBcryptCost)
-		if err != nil {
+		if err := s.setPassword(&user, user.Password); err != nil {
 			return err
 		}
-		user.Password = string(pwdHash)
 	} else {
 		user.Password = prev.Password
 		user.PwdExpirationDate = prev.PwdExpirationDate
 	}
-	user.LastUpdate = int(time.Now().Unix())
+	// MFA is managed only by MFA enrollment methods
+	user.MFA = prev.MFA
+	user.Source = prev.Source
+	user.InvalidAuthAttempt = prev.InvalidAuthAttempt
+	if user.Enabled && !prev.Enabled {
+		// enabling locked user resets failed login attempts
+		user.InvalidAuthAttempt = 0
+	}
+	user.LastUpdate = nextUpdate(prev.LastUpdate)
 	s.users[user.Username] = user
 	err := s.storeToDB(ctx)
 	if err != nil {
@@ -184,19 +361,21 @@ func (s *Service) CreateUser(ctx context.Context, user User) error {
 	if _, ok := s.users[user.Username]; ok {
 		return types.ErrAlreadyExists
 	}
+	if _, ok := s.serviceAccounts[user.Username]; ok {
+		return fmt.Errorf("%w: service account with name %q exists", types.ErrAlreadyExists, user.Username)
+	}
 	if err := s.validateUseRoles(user); err != nil {
 		return err
 	}
 	user.LastUpdate = int(time.Now().Unix())
-
-	pwdHash, err := bcrypt.GenerateFromPassword([]byte(user.Password), pwdBcryptCost)
-	if err != nil {
+	user.InvalidAuthAttempt = 0
+	user.Source = ""
+	if err := s.setPassword(&user, user.Password); err != nil {
 		return err
 	}
-	user.Password = string(pwdHash)
 
 	s.users[user.Username] = user
-	err = s.storeToDB(ctx)
+	err := s.storeToDB(ctx)
 	if err != nil {
 		//rollback changes
 		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
@@ -208,6 +387,53 @@ func (s *Service) CreateUser(ctx context.Context, user User) error {
 
 }
 
+// ProvisionExternalUser creates or updates user authenticated by external identity provider, e.g. LDAP.
+// External users are stored without password, so they cannot log in with local credentials.
+// Existing user keeps its enabled flag. Only users provisioned by the same identity source are updated,
+// so external login cannot take over local users or users without password, e.g. ceph dashboard SSO users.
+func (s *Service) ProvisionExternalUser(ctx context.Context, user User) (User, error) {
+	s.Lock()
+	defer s.Unlock()
+	if user.Source == "" {
+		return User{}, fmt.Errorf("%w: identity source of external user is required", types.ErrInvalidArg)
+	}
+	prev, exists := s.users[user.Username]
+	if exists && prev.Source != user.Source {
+		return User{}, fmt.Errorf("%w: user %q is not managed by %s", types.ErrAlreadyExists, user.Username, user.Source)
+	}
+	if _, ok := s.serviceAccounts[user.Username]; ok {
+		return User{}, fmt.Errorf("%w: service account with name %q exists", types.ErrAlreadyExists, user.Username)
+	}
+	if err := s.validateUseRoles(user); err != nil {
+		return User{}, err
+	}
+	user.Password = ""
+	user.PwdExpirationDate = nil
+	user.PwdUpdateRequired = false
+	user.MFA = nil
+	user.InvalidAuthAttempt = 0
+	if exists {
+		user.Enabled = prev.Enabled
+		user.MFA = prev.MFA
+		user.InvalidAuthAttempt = prev.InvalidAuthAttempt
+		user.LastUpdate = prev.LastUpdate
+		if reflect.DeepEqual(prev, user) {
+			return prev, nil
+		}
+	}
+	user.LastUpdate = nextUpdate(prev.LastUpdate)
+	s.users[user.Username] = user
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return User{}, err
+	}
+	return user, nil
+}
+
 func (s *Service) DeleteUser(ctx context.Context, username string) error {
 	s.Lock()
 	defer s.Unlock()
@@ -234,11 +460,16 @@ func (s *Service) ChangePassword(ctx context.Context, username, oldPass, newPass
 	if err != nil {
 		return fmt.Errorf("%w: invalid old password", err)
 	}
-	passHash, err := bcrypt.GenerateFromPassword([]byte(newPass), pwdBcryptCost)
-	if err != nil {
+	if s.conf.PwdPolicy.Enabled && s.conf.PwdPolicy.CheckOldPwd && oldPass == newPass {
+		return fmt.Errorf("%w: new password must differ from the old one", types.ErrInvalidArg)
+	}
+	// new password gets new expiration date
+	user.PwdExpirationDate = nil
+	if err = s.setPassword(&user, newPass); err != nil {
 		return err
 	}
-	user.Password = string(passHash)
+	user.PwdUpdateRequired = false
+	user.LastUpdate = nextUpdate(user.LastUpdate)
 	s.users[username] = user
 	err = s.storeToDB(ctx)
 	if err != nil {
@@ -261,6 +492,11 @@ type User struct {
 	Enabled           bool     `json:"enabled"`
 	PwdExpirationDate *int     `json:"pwdExpirationDate"`
 	PwdUpdateRequired bool     `json:"pwdUpdateRequired"`
+	MFA               *MFA     `json:"mfa,omitempty"`
+	// failed login attempts since last successful login
+	InvalidAuthAttempt int `json:"invalidAuthAttempt,omitempty"`
+	// Source is identity provider which provisioned user, e.g: SourceLDAP. Empty for local users.
+	Source string `json:"source,omitempty"`
 }
 
 func (u *User) Validate() error {
@@ -279,6 +515,9 @@ type Role struct {
 	Description *string             `json:"description"`
 	IsSystem    bool                `json:"system"`
 	Permissions map[string][]string `json:"scopes_permissions"`
+	// ResourcePermissions are stored separately from scope permissions, so Ceph dashboard,
+	// which is not aware of them, does not grant them for the whole scope.
+	ResourcePermissions []ResourcePermission `json:"resource_permissions,omitempty"`
 }
 
 func (r *Role) Validate() error {
@@ -296,13 +535,19 @@ func (r *Role) Validate() error {
 			}
 		}
 	}
+	for i := range r.ResourcePermissions {
+		if err := r.ResourcePermissions[i].Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 type db struct {
-	Users   map[string]User `json:"users"`
-	Roles   map[string]Role `json:"roles"`
-	Version int             `json:"version"`
+	Users           map[string]User           `json:"users"`
+	Roles           map[string]Role           `json:"roles"`
+	ServiceAccounts map[string]ServiceAccount `json:"service_accounts,omitempty"`
+	Version         int                       `json:"version"`
 }
 
 func (s *Service) ListRoles(ctx context.Context) ([]Role, error) {
@@ -357,17 +602,22 @@ func (s *Service) CreateRole(ctx context.Context, role Role) error {
 	}
 	return nil
 }
-func (s *Service) UpdateRole(ctx context.Context, role Role) error {
+
+// UpdateRole replaces role. If version is set, update is rejected when role was changed since given version was read.
+func (s *Service) UpdateRole(ctx context.Context, role Role, version string) error {
 	s.Lock()
 	defer s.Unlock()
 	_, exists := systemRoleMap[role.Name]
 	if exists || role.IsSystem {
 		return fmt.Errorf("%w: cannot update system role", types.ErrInvalidArg)
 	}
-	_, exists = s.roles[role.Name]
+	prev, exists := s.roles[role.Name]
 	if !exists {
 		return types.ErrNotFound
 	}
+	if err := checkVersion("role", role.Name, RoleVersion(prev), version); err != nil {
+		return err
+	}
 	if err := role.Validate(); err != nil {
 		return err
 	}
@@ -400,6 +650,11 @@ func (s *Service) DeleteRole(ctx context.Context, name string) error {
 			return fmt.Errorf("%w: role is in use", types.ErrInvalidArg)
 		}
 	}
+	for _, account := range s.serviceAccounts {
+		if slices.Contains(account.Roles, name) {
+			return fmt.Errorf("%w: role is in use by service account %s", types.ErrInvalidArg, account.Name)
+		}
+	}
 	delete(s.roles, name)
 	err := s.storeToDB(ctx)
 	if err != nil {
@@ -0,0 +1,286 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// APIKeyPrefix distinguishes API keys from JWT bearer tokens.
+	APIKeyPrefix = "cephapi_"
+	// min interval between persisting API key last used timestamp,
+	// to not write accessdb on every request.
+	apiKeyLastUsedInterval = time.Minute
+)
+
+// ServiceAccount is a non-human account used by automation.
+// Service account cannot log in with password and authenticates only with API keys.
+type ServiceAccount struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Roles       []string `json:"roles"`
+	Created     int      `json:"created"`
+	Keys        []APIKey `json:"keys"`
+}
+
+// APIKey is a long-lived credential of service account. Only SHA-256 hash of the key secret is stored.
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+	// Roles granted to the key. Subset of service account roles. All service account roles if empty.
+	Roles     []string `json:"roles"`
+	Created   int      `json:"created"`
+	ExpiresAt *int     `json:"expiresAt"`
+	LastUsed  *int     `json:"lastUsed"`
+}
+
+func (a *ServiceAccount) Validate() error {
+	if a.Name == "" {
+		return fmt.Errorf("%w: service account name required", types.ErrInvalidArg)
+	}
+	if len(a.Roles) == 0 {
+		return fmt.Errorf("%w: service account roles required", types.ErrInvalidArg)
+	}
+	return nil
+}
+
+func (k *APIKey) expired(now time.Time) bool {
+	return k.ExpiresAt != nil && int64(*k.ExpiresAt) <= now.Unix()
+}
+
+// IsAPIKey returns true if token has API key format.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+func (s *Service) ListServiceAccounts(ctx context.Context) ([]ServiceAccount, error) {
+	s.RLock()
+	defer s.RUnlock()
+	res := make([]ServiceAccount, 0, len(s.serviceAccounts))
+	for _, v := range s.serviceAccounts {
+		res = append(res, v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+func (s *Service) GetServiceAccount(ctx context.Context, name string) (ServiceAccount, error) {
+	s.RLock()
+	defer s.RUnlock()
+	res, ok := s.serviceAccounts[name]
+	if !ok {
+		return ServiceAccount{}, types.ErrNotFound
+	}
+	return res, nil
+}
+
+func (s *Service) CreateServiceAccount(ctx context.Context, account ServiceAccount) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	if _, ok := s.serviceAccounts[account.Name]; ok {
+		return types.ErrAlreadyExists
+	}
+	if _, ok := s.users[account.Name]; ok {
+		return fmt.Errorf("%w: user with name %q exists", types.ErrAlreadyExists, account.Name)
+	}
+	if err := s.validateUseRoles(User{Roles: account.Roles}); err != nil {
+		return err
+	}
+	account.Created = int(time.Now().Unix())
+	account.Keys = nil
+	s.serviceAccounts[account.Name] = account
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) DeleteServiceAccount(ctx context.Context, name string) error {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.serviceAccounts[name]; !ok {
+		return types.ErrNotFound
+	}
+	delete(s.serviceAccounts, name)
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return err
+	}
+	return nil
+}
+
+// CreateAPIKey creates new API key for service account.
+// Returns created key and token which should be used as bearer token. Token cannot be retrieved later.
+func (s *Service) CreateAPIKey(ctx context.Context, accountName string, key APIKey) (APIKey, string, error) {
+	s.Lock()
+	defer s.Unlock()
+	account, ok := s.serviceAccounts[accountName]
+	if !ok {
+		return APIKey{}, "", types.ErrNotFound
+	}
+	if key.Name == "" {
+		return APIKey{}, "", fmt.Errorf("%w: api key name required", types.ErrInvalidArg)
+	}
+	for _, k := range account.Keys {
+		if k.Name == key.Name {
+			return APIKey{}, "", fmt.Errorf("%w: api key %q already exists", types.ErrAlreadyExists, key.Name)
+		}
+	}
+	for _, r := range key.Roles {
+		if !slices.Contains(account.Roles, r) {
+			return APIKey{}, "", fmt.Errorf("%w: role %s is not granted to service account", types.ErrInvalidArg, r)
+		}
+	}
+	now := time.Now()
+	if key.expired(now) {
+		return APIKey{}, "", fmt.Errorf("%w: api key expiration date is in the past", types.ErrInvalidArg)
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	key.ID = id
+	key.Hash = sha256Hex(secret)
+	key.Created = int(now.Unix())
+	key.LastUsed = nil
+	account.Keys = append(slices.Clone(account.Keys), key)
+	s.serviceAccounts[accountName] = account
+	err = s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return APIKey{}, "", err
+	}
+	return key, APIKeyPrefix + id + "_" + secret, nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, accountName, keyID string) error {
+	s.Lock()
+	defer s.Unlock()
+	account, ok := s.serviceAccounts[accountName]
+	if !ok {
+		return types.ErrNotFound
+	}
+	idx := slices.IndexFunc(account.Keys, func(k APIKey) bool { return k.ID == keyID })
+	if idx < 0 {
+		return types.ErrNotFound
+	}
+	account.Keys = slices.Delete(slices.Clone(account.Keys), idx, idx+1)
+	s.serviceAccounts[accountName] = account
+	err := s.storeToDB(ctx)
+	if err != nil {
+		//rollback changes
+		if rollbackErr := s.updateFromDB(ctx); rollbackErr != nil {
+			zerolog.Ctx(ctx).Err(rollbackErr).Msg("unable to rollback access db")
+		}
+		return err
+	}
+	return nil
+}
+
+// AuthenticateAPIKey verifies API key token and returns service account name and roles granted to the key.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, token string) (string, []string, error) {
+	keyID, secret, ok := strings.Cut(strings.TrimPrefix(token, APIKeyPrefix), "_")
+	if !IsAPIKey(token) || !ok {
+		return "", nil, fmt.Errorf("%w: invalid api key format", types.ErrUnauthenticated)
+	}
+	now := time.Now()
+	s.RLock()
+	account, key, found := s.findAPIKey(keyID)
+	s.RUnlock()
+	if !found || subtle.ConstantTimeCompare([]byte(key.Hash), []byte(sha256Hex(secret))) != 1 {
+		return "", nil, fmt.Errorf("%w: invalid api key", types.ErrUnauthenticated)
+	}
+	if key.expired(now) {
+		return "", nil, fmt.Errorf("%w: api key expired", types.ErrUnauthenticated)
+	}
+	roles := account.Roles
+	if len(key.Roles) != 0 {
+		roles = key.Roles
+	}
+	if key.LastUsed == nil || now.Sub(time.Unix(int64(*key.LastUsed), 0)) > apiKeyLastUsedInterval {
+		s.touchAPIKey(ctx, account.Name, keyID, now)
+	}
+	return account.Name, roles, nil
+}
+
+// findAPIKey returns key by id. Caller must hold read lock.
+func (s *Service) findAPIKey(keyID string) (ServiceAccount, APIKey, bool) {
+	for _, account := range s.serviceAccounts {
+		for _, k := range account.Keys {
+			if k.ID == keyID {
+				return account, k, true
+			}
+		}
+	}
+	return ServiceAccount{}, APIKey{}, false
+}
+
+// touchAPIKey updates API key last used timestamp. Errors are logged, because they should not fail authentication.
+func (s *Service) touchAPIKey(ctx context.Context, accountName, keyID string, now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	err := s.storeChange(ctx, func() (bool, error) {
+		account, ok := s.serviceAccounts[accountName]
+		if !ok {
+			return false, nil
+		}
+		idx := slices.IndexFunc(account.Keys, func(k APIKey) bool { return k.ID == keyID })
+		if idx < 0 {
+			return false, nil
+		}
+		lastUsed := int(now.Unix())
+		account.Keys = slices.Clone(account.Keys)
+		account.Keys[idx].LastUsed = &lastUsed
+		s.serviceAccounts[accountName] = account
+		return true, nil
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("service_account", accountName).Msg("unable to store api key last used time")
+	}
+}
+
+func sha256Hex(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
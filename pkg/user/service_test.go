@@ -0,0 +1,156 @@
+//go:build mock
+
+package user
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/rados"
+	"github.com/clyso/ceph-api/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ConcurrentInstances(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+	conf := Config{BcryptPwdCost: 4, AccessDBLockPool: ".mgr", AccessDBPollInterval: 10 * time.Millisecond}
+	// two instances sharing the same accessdb
+	s1, err := New(radosSvc, conf)
+	r.NoError(err)
+	s2, err := New(radosSvc, conf)
+	r.NoError(err)
+
+	r.NoError(s1.CreateRole(ctx, Role{Name: "role-1", Permissions: map[string][]string{"pool": {"read"}}}))
+
+	// write based on outdated state is rejected and latest state is loaded
+	err = s2.CreateRole(ctx, Role{Name: "role-2", Permissions: map[string][]string{"pool": {"read"}}})
+	r.ErrorIs(err, types.ErrConflict)
+	_, err = s2.GetRole(ctx, "role-1")
+	r.NoError(err)
+	_, err = s2.GetRole(ctx, "role-2")
+	r.ErrorIs(err, types.ErrNotFound)
+	r.NoError(s2.CreateRole(ctx, Role{Name: "role-2", Permissions: map[string][]string{"pool": {"read"}}}), "retry succeeds")
+
+	// change is picked up by watcher
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s1.WatchDB(watchCtx)
+	r.Eventually(func() bool {
+		_, err := s1.GetRole(ctx, "role-2")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	r.NoError(s1.DeleteRole(ctx, "role-2"))
+}
+
+func TestNew_LockPoolRequired(t *testing.T) {
+	conn, err := rados.NewMockConn()
+	require.NoError(t, err)
+	radosSvc, err := rados.New(conn)
+	require.NoError(t, err)
+	_, err = New(radosSvc, Config{BcryptPwdCost: 4})
+	require.ErrorIs(t, err, types.ErrInvalidConfig)
+}
+
+func TestService_ConcurrentInstances_FailedLogins(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+	conf := Config{BcryptPwdCost: 4, AccountLockoutAttempts: 100, AccessDBLockPool: ".mgr", AccessDBLockNamespace: "ceph-api"}
+	s1, err := New(radosSvc, conf)
+	r.NoError(err)
+	r.NoError(s1.CreateUser(ctx, User{Username: "user", Password: "user-pass", Roles: []string{"read-only"}, Enabled: true}))
+	s2, err := New(radosSvc, conf)
+	r.NoError(err)
+	r.NoError(s1.CreateRole(ctx, Role{Name: "role-1", Permissions: map[string][]string{"pool": {"read"}}}))
+
+	// failed logins on both instances are counted, although s2 state is outdated
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		for _, s := range []*Service{s1, s2} {
+			wg.Add(1)
+			go func(s *Service) {
+				defer wg.Done()
+				s.recordFailedLogin(ctx, "user")
+			}(s)
+		}
+	}
+	wg.Wait()
+	r.NoError(s1.updateFromDB(ctx))
+	usr, err := s1.GetUser(ctx, "user")
+	r.NoError(err)
+	r.Equal(10, usr.InvalidAuthAttempt)
+	_, err = s1.GetRole(ctx, "role-1")
+	r.NoError(err, "concurrent change is not overwritten")
+
+	// write waits for lock held by other instance
+	unlock, err := radosSvc.LockExclusive(ctx, conf.AccessDBLockPool, conf.AccessDBLockNamespace, accessDBLockObj, accessDBLockName, time.Minute)
+	r.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		s2.ResetFailedLogins(ctx, "user")
+		close(done)
+	}()
+	select {
+	case <-done:
+		r.Fail("write is done while accessdb is locked")
+	case <-time.After(300 * time.Millisecond):
+	}
+	unlock()
+	<-done
+	r.NoError(s1.updateFromDB(ctx))
+	usr, err = s1.GetUser(ctx, "user")
+	r.NoError(err)
+	r.Zero(usr.InvalidAuthAttempt)
+}
+
+func TestService_ProvisionExternalUser(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	conn, err := rados.NewMockConn()
+	r.NoError(err)
+	radosSvc, err := rados.New(conn)
+	r.NoError(err)
+	s, err := New(radosSvc, Config{BcryptPwdCost: 4, AccessDBLockPool: ".mgr"})
+	r.NoError(err)
+
+	r.NoError(s.CreateUser(ctx, User{Username: "local", Password: "local-pass", Roles: []string{"read-only"}, Enabled: true}))
+	// user without password, e.g. created by ceph dashboard SSO
+	s.Lock()
+	s.users["dashboard-sso"] = User{Username: "dashboard-sso", Roles: []string{"administrator"}, Enabled: true}
+	r.NoError(s.storeToDB(ctx))
+	s.Unlock()
+
+	_, err = s.ProvisionExternalUser(ctx, User{Username: "local", Roles: []string{"administrator"}, Enabled: true, Source: SourceLDAP})
+	r.ErrorIs(err, types.ErrAlreadyExists)
+	_, err = s.ProvisionExternalUser(ctx, User{Username: "dashboard-sso", Roles: []string{"read-only"}, Enabled: true, Source: SourceLDAP})
+	r.ErrorIs(err, types.ErrAlreadyExists)
+	_, err = s.ProvisionExternalUser(ctx, User{Username: "no-source", Roles: []string{"read-only"}, Enabled: true})
+	r.ErrorIs(err, types.ErrInvalidArg)
+	usr, err := s.GetUser(ctx, "dashboard-sso")
+	r.NoError(err)
+	r.Equal([]string{"administrator"}, usr.Roles, "user is not changed")
+
+	usr, err = s.ProvisionExternalUser(ctx, User{Username: "ldap-user", Roles: []string{"read-only"}, Enabled: true, Source: SourceLDAP})
+	r.NoError(err)
+	r.Equal(SourceLDAP, usr.Source)
+	usr, err = s.ProvisionExternalUser(ctx, User{Username: "ldap-user", Roles: []string{"administrator"}, Enabled: true, Source: SourceLDAP})
+	r.NoError(err)
+	r.Equal([]string{"administrator"}, usr.Roles, "user provisioned by the same source is updated")
+
+	// source is kept on update by administrator
+	usr.Roles = []string{"read-only"}
+	r.NoError(s.UpdateUser(ctx, usr, ""))
+	usr, err = s.GetUser(ctx, "ldap-user")
+	r.NoError(err)
+	r.Equal(SourceLDAP, usr.Source)
+}
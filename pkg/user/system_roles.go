@@ -64,7 +64,7 @@ const (
 	ScopeGrafana           Scope = "grafana"
 	ScopePrometheus        Scope = "prometheus"
 	ScopeUser              Scope = "user"
-	ScopeDashboardSettings Scope = "dashboard-setting"
+	ScopeDashboardSettings Scope = "dashboard-settings"
 	ScopeNfsGanesha        Scope = "nfs-ganesha"
 	ScopeNvmeOf            Scope = "nvme-of"
 )
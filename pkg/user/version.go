@@ -0,0 +1,36 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/clyso/ceph-api/pkg/types"
+)
+
+// UserVersion returns user version for optimistic concurrency control. User last update time is used as version.
+func UserVersion(u User) string {
+	return strconv.Itoa(u.LastUpdate)
+}
+
+// RoleVersion returns role version for optimistic concurrency control.
+// Roles don't have update time, so checksum of role content is used as version.
+func RoleVersion(r Role) string {
+	raw, _ := json.Marshal(r)
+	return sha256Hex(string(raw))[:16]
+}
+
+// checkVersion returns ErrFailedPrecondition if expected version is set and differs from current.
+func checkVersion(kind, name, current, expected string) error {
+	if expected == "" || expected == current {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %q was changed by someone else, current version is %q", types.ErrFailedPrecondition, kind, name, current)
+}
+
+// nextUpdate returns last update time of changed user.
+// Time is incremented if user was updated within the same second, so user version is changed on every update.
+func nextUpdate(prev int) int {
+	return max(int(time.Now().Unix()), prev+1)
+}
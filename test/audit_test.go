@@ -0,0 +1,71 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_Audit(t *testing.T) {
+	r := require.New(t)
+	const (
+		username = "e2e-audit-user"
+		password = "Audit-e2e-Pass-73"
+	)
+	usersClient := pb.NewUsersClient(admConn)
+	auditClient := pb.NewAuditClient(admConn)
+	from := timestamppb.New(time.Now().Add(-time.Second))
+
+	_, err := usersClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: password, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		usersClient.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+	// failed call is audited as well
+	_, err = usersClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: password, Roles: []string{"read-only"}, Enabled: true})
+	r.Error(err)
+	// read calls are not audited
+	_, err = usersClient.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+
+	res, err := auditClient.ListEvents(tstCtx, &pb.ListAuditEventsReq{
+		From:     from,
+		Username: proto.String(admin),
+		Method:   proto.String("/ceph.Users/CreateUser"),
+	})
+	r.NoError(err)
+	r.Len(res.Events, 2)
+	created, failed := res.Events[0], res.Events[1]
+	r.Equal("OK", created.Code)
+	r.Equal(admin, created.Username)
+	r.NotEmpty(created.TraceId)
+	r.Contains(created.Request, username)
+	r.NotContains(created.Request, password, "password is redacted")
+	r.Equal("AlreadyExists", failed.Code)
+	r.NotEmpty(failed.Error)
+
+	res, err = auditClient.ListEvents(tstCtx, &pb.ListAuditEventsReq{From: from, Method: proto.String("/ceph.Users/GetUser")})
+	r.NoError(err)
+	r.Empty(res.Events)
+
+	// paging
+	res, err = auditClient.ListEvents(tstCtx, &pb.ListAuditEventsReq{From: from, Username: proto.String(admin), Limit: proto.Int32(1)})
+	r.NoError(err)
+	r.Len(res.Events, 1)
+	r.NotNil(res.NextPageToken)
+	next, err := auditClient.ListEvents(tstCtx, &pb.ListAuditEventsReq{From: from, Username: proto.String(admin), Limit: proto.Int32(1), PageToken: res.NextPageToken})
+	r.NoError(err)
+	r.Len(next.Events, 1)
+	r.True(next.Events[0].Time.AsTime().After(res.Events[0].Time.AsTime()) || next.Events[0].Time.AsTime().Equal(res.Events[0].Time.AsTime()))
+
+	// audit log is not readable with read-only role
+	userCtx, _, err := authenticateGrpcOauth(username, password)
+	r.NoError(err)
+	_, err = pb.NewAuditClient(grpcConn).ListEvents(userCtx, &pb.ListAuditEventsReq{From: from})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+}
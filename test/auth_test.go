@@ -3,10 +3,14 @@ package test
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/metadata"
@@ -38,11 +42,19 @@ func Test_Auth_grpc_API(t *testing.T) {
 	_, err = clusterClient.GetStatus(authCtx, &emptypb.Empty{})
 	r.NoError(err)
 
+	checkRes, err := client.Check(tstCtx, &pb.TokenCheckReq{Token: res.Token})
+	r.NoError(err)
+	r.EqualValues(admin, checkRes.Username)
+	r.False(checkRes.Sso)
+	r.NotEmpty(checkRes.Permissions)
+
 	_, err = client.Logout(authCtx, &emptypb.Empty{})
 	r.NoError(err)
 
 	_, err = clusterClient.GetStatus(authCtx, &emptypb.Empty{})
 	r.Error(err)
+	_, err = client.Check(tstCtx, &pb.TokenCheckReq{Token: res.Token})
+	r.Error(err)
 
 }
 func Test_Auth_Oauth_API(t *testing.T) {
@@ -80,3 +92,43 @@ func authenticateGrpcOauth(login, pass string) (context.Context, *oauth2.Token,
 	)
 	return metadata.NewOutgoingContext(context.Background(), md), token, nil
 }
+
+func Test_Auth_OIDC_Discovery(t *testing.T) {
+	r := require.New(t)
+
+	discovery := struct {
+		Issuer        string `json:"issuer"`
+		TokenEndpoint string `json:"token_endpoint"`
+		JWKSURI       string `json:"jwks_uri"`
+	}{}
+	resp, err := http.Get(httpAddr + "/.well-known/openid-configuration")
+	r.NoError(err)
+	defer resp.Body.Close()
+	r.EqualValues(http.StatusOK, resp.StatusCode)
+	r.NoError(json.NewDecoder(resp.Body).Decode(&discovery))
+	r.EqualValues(conf.Auth.Issuer, discovery.Issuer)
+	r.EqualValues(httpAddr+"/api/oauth/token", discovery.TokenEndpoint)
+	r.EqualValues(httpAddr+"/.well-known/jwks.json", discovery.JWKSURI)
+
+	var jwks jose.JSONWebKeySet
+	jwksResp, err := http.Get(discovery.JWKSURI)
+	r.NoError(err)
+	defer jwksResp.Body.Close()
+	r.EqualValues(http.StatusOK, jwksResp.StatusCode)
+	r.NoError(json.NewDecoder(jwksResp.Body).Decode(&jwks))
+	r.NotEmpty(jwks.Keys)
+
+	// token issued by ceph-api can be verified with published key
+	_, token, err := authenticateGrpcOauth(conf.App.AdminUsername, conf.App.AdminPassword)
+	r.NoError(err)
+	parsed, err := jwt.Parse(token.AccessToken, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		keys := jwks.Key(kid)
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("key %q not found", kid)
+		}
+		return keys[0].Key, nil
+	})
+	r.NoError(err)
+	r.True(parsed.Valid)
+}
@@ -0,0 +1,177 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/clyso/ceph-api/pkg/app"
+	"github.com/clyso/ceph-api/pkg/auth"
+	"github.com/clyso/ceph-api/pkg/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert) testCert {
+	t.Helper()
+	r := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	r.NoError(err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	r.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	r.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	r.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	r.NoError(err)
+	return testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func Test_ClientCertAuth(t *testing.T) {
+	r := require.New(t)
+	const (
+		saName        = "e2e-cert-sa"
+		saIdentity    = "automation.e2e.test"
+		adminIdentity = "admin.e2e.test"
+	)
+	dir := t.TempDir()
+	ca := newTestCert(t, "ceph-api e2e ca", nil)
+	srvCert := newTestCert(t, "localhost", &ca)
+	otherCA := newTestCert(t, "other ca", nil)
+	r.NoError(os.WriteFile(filepath.Join(dir, "ca.pem"), ca.certPEM, 0o600))
+	r.NoError(os.WriteFile(filepath.Join(dir, "tls.crt"), srvCert.certPEM, 0o600))
+	r.NoError(os.WriteFile(filepath.Join(dir, "tls.key"), srvCert.keyPEM, 0o600))
+
+	// start separate secure app instance
+	secureConf := conf
+	secureConf.Api.Secure = true
+	secureConf.Api.CertFile = filepath.Join(dir, "tls.crt")
+	secureConf.Api.KeyFile = filepath.Join(dir, "tls.key")
+	secureConf.Api.ClientCAFile = filepath.Join(dir, "ca.pem")
+	port, _ := getRandomPort()
+	secureConf.Api.GrpcPort = port
+	secureConf.Api.HttpPort = port
+	secureConf.Metrics.Enabled = false
+	secureConf.Auth.ClientCert = auth.ClientCertConfig{
+		Enabled: true,
+		Mapping: []auth.CertIdentity{{Identity: saIdentity, Account: saName}, {Identity: adminIdentity, Account: admin}, {Identity: "unknown.e2e.test", Account: "unknown-e2e-account"}},
+	}
+	ctx, cancel := context.WithCancel(tstCtx)
+	t.Cleanup(cancel)
+	go func() {
+		_ = app.Start(ctx, secureConf, config.Build{Version: "test"})
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	dial := func(client *testCert) *grpc.ClientConn {
+		tlsConf := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if client != nil {
+			pair, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+			r.NoError(err)
+			tlsConf.Certificates = []tls.Certificate{pair}
+		}
+		dialCtx, dialCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer dialCancel()
+		conn, err := grpc.DialContext(dialCtx, fmt.Sprintf("localhost:%d", port),
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)),
+			grpc.WithBlock(),
+		)
+		r.NoError(err)
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+
+	// user certificate authenticates without bearer token
+	adminCert := newTestCert(t, adminIdentity, &ca)
+	conn := dial(&adminCert)
+	_, err := pb.NewClusterClient(conn).GetStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+	// service account is created in accessdb of the secure instance
+	_, err = pb.NewUsersClient(conn).CreateServiceAccount(ctx, &pb.ServiceAccount{Name: saName, Roles: []string{"read-only"}})
+	r.NoError(err, "admin has user management permissions")
+
+	// mapped identity authenticates as service account with its roles
+	saCert := newTestCert(t, saIdentity, &ca)
+	conn = dial(&saCert)
+	_, err = pb.NewStatusClient(conn).GetCephStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+	_, err = pb.NewUsersClient(conn).CreateUser(ctx, &pb.CreateUserReq{Username: "e2e-cert-user", Password: "Cert-e2e-Pass-73", Roles: []string{"read-only"}})
+	r.Error(err, "read-only service account cannot manage users")
+	r.Contains(err.Error(), "PermissionDenied")
+
+	// not mapped identity is not used as account name
+	unmappedCert := newTestCert(t, admin, &ca)
+	conn = dial(&unmappedCert)
+	_, err = pb.NewClusterClient(conn).GetStatus(ctx, &emptypb.Empty{})
+	r.Error(err)
+	r.Contains(err.Error(), "Unauthenticated")
+
+	// identity mapped to unknown account is rejected
+	unknownCert := newTestCert(t, "unknown.e2e.test", &ca)
+	conn = dial(&unknownCert)
+	_, err = pb.NewClusterClient(conn).GetStatus(ctx, &emptypb.Empty{})
+	r.Error(err)
+	r.Contains(err.Error(), "Unauthenticated")
+
+	// no certificate and no token is rejected
+	conn = dial(nil)
+	_, err = pb.NewClusterClient(conn).GetStatus(ctx, &emptypb.Empty{})
+	r.Error(err)
+	r.Contains(err.Error(), "Unauthenticated")
+
+	// certificate issued by unknown CA fails handshake
+	foreignCert := newTestCert(t, admin, &otherCA)
+	pair, err := tls.X509KeyPair(foreignCert.certPEM, foreignCert.keyPEM)
+	r.NoError(err)
+	foreignConn, err := grpc.DialContext(ctx, fmt.Sprintf("localhost:%d", port),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{pair}})),
+	)
+	r.NoError(err)
+	defer foreignConn.Close()
+	_, err = pb.NewClusterClient(foreignConn).GetStatus(ctx, &emptypb.Empty{})
+	r.Error(err)
+}
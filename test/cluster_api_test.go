@@ -7,6 +7,7 @@ import (
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -368,3 +369,114 @@ func Test_SearchConfig(t *testing.T) {
 		}
 	}
 }
+
+func Test_ConfigGetSetRemove(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterClient(admConn)
+	const who, name = "osd.0", "osd_max_backfills"
+
+	_, err := client.SetConfig(tstCtx, &pb.SetConfigRequest{Who: who, Name: name, Value: "3"})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.RemoveConfig(tstCtx, &pb.RemoveConfigRequest{Who: who, Name: name})
+	})
+
+	res, err := client.GetConfig(tstCtx, &pb.GetConfigRequest{Who: who, Name: name})
+	r.NoError(err)
+	r.EqualValues("3", res.Value)
+
+	dump, err := client.DumpConfig(tstCtx, &pb.DumpConfigRequest{Who: proto.String(who)})
+	r.NoError(err)
+	found := false
+	for _, p := range dump.Params {
+		r.EqualValues(who, p.Section)
+		if p.Name == name {
+			found = true
+			r.EqualValues("3", p.Value)
+		}
+	}
+	r.True(found)
+
+	_, err = client.RemoveConfig(tstCtx, &pb.RemoveConfigRequest{Who: who, Name: name})
+	r.NoError(err)
+
+	dump, err = client.DumpConfig(tstCtx, &pb.DumpConfigRequest{Who: proto.String(who)})
+	r.NoError(err)
+	for _, p := range dump.Params {
+		r.NotEqualValues(name, p.Name)
+	}
+}
+
+func Test_SetConfigRestartRequired(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterClient(admConn)
+	const who, name = "osd.0", "osd_op_queue"
+
+	res, err := client.SetConfig(tstCtx, &pb.SetConfigRequest{Who: who, Name: name, Value: "wpq"})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.RemoveConfig(tstCtx, &pb.RemoveConfigRequest{Who: who, Name: name})
+	})
+	r.True(res.RestartRequired)
+}
+
+func Test_SetConfigInvalidValue(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterClient(admConn)
+
+	for _, req := range []*pb.SetConfigRequest{
+		{Who: "osd", Name: "osd_max_backfills", Value: "-1"},
+		{Who: "osd", Name: "osd_op_queue", Value: "unknown"},
+		{Who: "osd", Name: "this_param_does_not_exist", Value: "1"},
+		{Who: "", Name: "osd_max_backfills", Value: "1"},
+	} {
+		_, err := client.SetConfig(tstCtx, req)
+		r.Error(err)
+		r.Contains(err.Error(), "InvalidArgument")
+	}
+}
+
+func Test_ConfigHistoryAndRollback(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterClient(admConn)
+	const who, name = "osd.0", "osd_max_backfills"
+
+	before, err := client.GetConfigHistory(tstCtx, &pb.GetConfigHistoryRequest{PageSize: proto.Int32(1)})
+	r.NoError(err)
+	r.Len(before.Entries, 1)
+	versionBefore := before.Entries[0].Version
+
+	_, err = client.SetConfig(tstCtx, &pb.SetConfigRequest{Who: who, Name: name, Value: "4"})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.RemoveConfig(tstCtx, &pb.RemoveConfigRequest{Who: who, Name: name})
+	})
+
+	history, err := client.GetConfigHistory(tstCtx, &pb.GetConfigHistoryRequest{PageSize: proto.Int32(1)})
+	r.NoError(err)
+	r.Len(history.Entries, 1)
+	last := history.Entries[0]
+	r.Greater(last.Version, versionBefore)
+	r.Len(last.Changes, 1)
+	r.EqualValues(who, last.Changes[0].Who)
+	r.EqualValues(name, last.Changes[0].Name)
+	r.EqualValues("4", last.Changes[0].GetNewValue())
+	r.EqualValues("uint", last.Changes[0].Type)
+	r.NotEmpty(last.Changes[0].Desc)
+
+	// next page starts right after the last entry
+	r.NotNil(history.NextPageToken)
+	next, err := client.GetConfigHistory(tstCtx, &pb.GetConfigHistoryRequest{PageSize: proto.Int32(1), PageToken: history.NextPageToken})
+	r.NoError(err)
+	r.Len(next.Entries, 1)
+	r.EqualValues(versionBefore, next.Entries[0].Version)
+
+	_, err = client.RollbackConfig(tstCtx, &pb.RollbackConfigRequest{Version: versionBefore})
+	r.NoError(err)
+
+	dump, err := client.DumpConfig(tstCtx, &pb.DumpConfigRequest{Who: proto.String(who)})
+	r.NoError(err)
+	for _, p := range dump.Params {
+		r.NotEqualValues(name, p.Name)
+	}
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_ClusterState_PlanApply(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterStateClient(admConn)
+	poolClient := pb.NewPoolClient(admConn)
+	clusterClient := pb.NewClusterClient(admConn)
+
+	const poolName, who, param = "test_state_pool", "osd.0", "osd_max_backfills"
+	state := &pb.DesiredState{
+		Config: []*pb.SetConfigRequest{{Who: who, Name: param, Value: "5"}},
+		Pools:  []*pb.CreatePoolRequest{{Name: poolName, PgNum: proto.Int32(8), Application: proto.String("rbd")}},
+	}
+	t.Cleanup(func() {
+		poolClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: poolName, Confirm: true})
+		clusterClient.RemoveConfig(tstCtx, &pb.RemoveConfigRequest{Who: who, Name: param})
+	})
+
+	plan, err := client.Plan(tstCtx, state)
+	r.NoError(err)
+	r.Len(plan.Items, 2)
+	// pools are planned before config
+	r.EqualValues(pb.PlanItem_pool, plan.Items[0].Kind)
+	r.EqualValues(pb.PlanItem_create, plan.Items[0].Action)
+	r.EqualValues(pb.PlanItem_config, plan.Items[1].Kind)
+	r.EqualValues(who+"/"+param, plan.Items[1].Name)
+	r.EqualValues(pb.PlanItem_create, plan.Items[1].Action)
+
+	// dry run does not change anything
+	res, err := client.Apply(tstCtx, &pb.ApplyRequest{State: state, DryRun: true})
+	r.NoError(err)
+	for _, item := range res.Results {
+		r.EqualValues(pb.ApplyItemResult_skipped, item.Status)
+	}
+	_, err = poolClient.GetPool(tstCtx, &pb.GetPoolRequest{Name: poolName})
+	r.Error(err)
+
+	res, err = client.Apply(tstCtx, &pb.ApplyRequest{State: state})
+	r.NoError(err)
+	for _, item := range res.Results {
+		r.EqualValues(pb.ApplyItemResult_applied, item.Status, item.GetError())
+	}
+
+	// cluster is in desired state now
+	plan, err = client.Plan(tstCtx, state)
+	r.NoError(err)
+	for _, item := range plan.Items {
+		r.EqualValues(pb.PlanItem_no_change, item.Action, item.Name)
+	}
+
+	state.Config[0].Value = "6"
+	plan, err = client.Plan(tstCtx, state)
+	r.NoError(err)
+	r.EqualValues(pb.PlanItem_update, plan.Items[1].Action)
+	r.Len(plan.Items[1].Diff, 1)
+	r.EqualValues("5", plan.Items[1].Diff[0].GetCurrent())
+	r.EqualValues("6", plan.Items[1].Diff[0].Desired)
+}
+
+func Test_ClusterState_InvalidPlanIsNotApplied(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewClusterStateClient(admConn)
+	clusterClient := pb.NewClusterClient(admConn)
+
+	state := &pb.DesiredState{
+		Config: []*pb.SetConfigRequest{
+			{Who: "osd.0", Name: "osd_recovery_max_active", Value: "4"},
+			{Who: "osd.0", Name: "osd_max_backfills", Value: "-1"},
+		},
+	}
+	res, err := client.Apply(tstCtx, &pb.ApplyRequest{State: state})
+	r.NoError(err)
+	r.Len(res.Results, 2)
+	r.EqualValues(pb.ApplyItemResult_skipped, res.Results[0].Status)
+	r.EqualValues(pb.ApplyItemResult_failed, res.Results[1].Status)
+	r.NotEmpty(res.Results[1].GetError())
+
+	dump, err := clusterClient.DumpConfig(tstCtx, &pb.DumpConfigRequest{Who: proto.String("osd.0")})
+	r.NoError(err)
+	for _, p := range dump.Params {
+		r.NotEqualValues("osd_recovery_max_active", p.Name)
+	}
+}
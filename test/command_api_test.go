@@ -0,0 +1,123 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_ListCommands(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewCommandClient(admConn)
+	res, err := client.ListCommands(tstCtx, &pb.ListCommandsRequest{Prefix: proto.String("osd pool create")})
+	r.NoError(err)
+	r.NotEmpty(res.Commands)
+	cmd := res.Commands[0]
+	r.EqualValues("osd pool create", cmd.Prefix)
+	r.EqualValues("mon", cmd.Target)
+	r.Contains(cmd.Perm, "w")
+	var argNames []string
+	for _, a := range cmd.Args {
+		argNames = append(argNames, a.Name)
+	}
+	r.Contains(argNames, "pool")
+
+	res, err = client.ListCommands(tstCtx, &pb.ListCommandsRequest{Prefix: proto.String("balancer status")})
+	r.NoError(err)
+	r.Len(res.Commands, 1)
+	r.EqualValues("mgr", res.Commands[0].Target)
+}
+
+func Test_ExecCommand(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewCommandClient(admConn)
+
+	res, err := client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "mon dump"})
+	r.NoError(err)
+	r.EqualValues("mon", res.Target)
+	r.NotNil(res.Result.GetStructValue())
+	r.NotNil(res.Result.GetStructValue().Fields["fsid"])
+
+	res, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "balancer status"})
+	r.NoError(err)
+	r.EqualValues("mgr", res.Target)
+	r.NotNil(res.Result.GetStructValue())
+
+	res, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "dashboard get-grafana-api-url"})
+	r.NoError(err)
+	r.EqualValues("mgr", res.Target)
+	r.NotEmpty(res.Result.GetStringValue())
+
+	args, err := structpb.NewStruct(map[string]any{"format": "json"})
+	r.NoError(err)
+	res, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "osd pool ls", Args: args})
+	r.NoError(err)
+	r.NotEmpty(res.Result.GetListValue().GetValues())
+
+	_, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "osd frobnicate"})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	args, err = structpb.NewStruct(map[string]any{"epoch": -1})
+	r.NoError(err)
+	_, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "mon dump", Args: args})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	args, err = structpb.NewStruct(map[string]any{"pool": "e2e-cmd-pool", "pool_type": "mirrored"})
+	r.NoError(err)
+	_, err = client.ExecCommand(tstCtx, &pb.ExecCommandRequest{Prefix: "osd pool create", Args: args})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
+
+func Test_ExecCommand_Permissions(t *testing.T) {
+	r := require.New(t)
+	const (
+		username = "e2e-cmd-read-only"
+		pwd      = "ReadOnly-e2e-Pass-91"
+	)
+	usersClient := pb.NewUsersClient(admConn)
+	_, err := usersClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		usersClient.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+	ctx, _, err := authenticateGrpcOauth(username, pwd)
+	r.NoError(err)
+	client := pb.NewCommandClient(grpcConn)
+
+	// any reader can list commands
+	res, err := client.ListCommands(ctx, &pb.ListCommandsRequest{Prefix: proto.String("osd pool create")})
+	r.NoError(err)
+	r.NotEmpty(res.Commands)
+
+	// read command
+	_, err = client.ExecCommand(ctx, &pb.ExecCommandRequest{Prefix: "osd dump"})
+	r.NoError(err)
+
+	// write command
+	args, err := structpb.NewStruct(map[string]any{"pool": "e2e-cmd-pool"})
+	r.NoError(err)
+	_, err = client.ExecCommand(ctx, &pb.ExecCommandRequest{Prefix: "osd pool create", Args: args})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	// read command exposing credentials
+	args, err = structpb.NewStruct(map[string]any{"key": "mgr/ceph-api/accessdb"})
+	r.NoError(err)
+	_, err = client.ExecCommand(ctx, &pb.ExecCommandRequest{Prefix: "config-key get", Args: args})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+	_, err = client.ExecCommand(ctx, &pb.ExecCommandRequest{Prefix: "restful list-keys"})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	// read command without scope mapping
+	_, err = client.ExecCommand(ctx, &pb.ExecCommandRequest{Prefix: "balancer status"})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+}
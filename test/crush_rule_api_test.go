@@ -51,8 +51,16 @@ func Test_Create_Get_And_Delete(t *testing.T) {
 	// Check if "some_rule" is in the list
 	r.Contains(ruleNames, "some_rule", "The rule 'some_rule' should be present in the list of rules")
 
+	r.NotEmpty(res.Version)
 	_, err = client.DeleteRule(tstCtx, &pb.DeleteRuleRequest{
-		Name: "some_rule",
+		Name:    "some_rule",
+		Version: proto.String("outdated"),
+	})
+	r.Error(err, "rule version mismatch")
+
+	_, err = client.DeleteRule(tstCtx, &pb.DeleteRuleRequest{
+		Name:    "some_rule",
+		Version: proto.String(res.Version),
 	})
 	r.NoError(err)
 
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_ListErasureCodeProfiles(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewErasureCodeProfileClient(admConn)
+	res, err := client.ListProfiles(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.NotEmpty(res.Profiles)
+}
+
+func Test_ErasureCodeProfile_Create_Get_Delete(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewErasureCodeProfileClient(admConn)
+	const profileName = "test_ec_profile"
+
+	_, err := client.CreateProfile(tstCtx, &pb.CreateProfileRequest{
+		Name:               profileName,
+		K:                  2,
+		M:                  1,
+		CrushFailureDomain: proto.String("osd"),
+	})
+	r.NoError(err)
+
+	res, err := client.GetProfile(tstCtx, &pb.GetProfileRequest{Name: profileName})
+	r.NoError(err)
+	r.EqualValues(profileName, res.Name)
+	r.EqualValues(2, res.K)
+	r.EqualValues(1, res.M)
+	r.EqualValues("jerasure", res.Plugin)
+	r.EqualValues("osd", res.CrushFailureDomain)
+
+	listRes, err := client.ListProfiles(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	var names []string
+	for _, p := range listRes.Profiles {
+		names = append(names, p.Name)
+	}
+	r.Contains(names, profileName)
+
+	_, err = client.DeleteProfile(tstCtx, &pb.DeleteProfileRequest{Name: profileName})
+	r.NoError(err)
+
+	_, err = client.GetProfile(tstCtx, &pb.GetProfileRequest{Name: profileName})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+}
+
+func Test_CreateInvalidErasureCodeProfile(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewErasureCodeProfileClient(admConn)
+
+	for name, req := range map[string]*pb.CreateProfileRequest{
+		"no name":          {K: 2, M: 1},
+		"small k":          {Name: "invalid", K: 1, M: 1},
+		"small m":          {Name: "invalid", K: 2, M: 0},
+		"unknown plugin":   {Name: "invalid", K: 2, M: 1, Plugin: proto.String("unknown")},
+		"unknown domain":   {Name: "invalid", K: 2, M: 1, CrushFailureDomain: proto.String("unknown")},
+		"lrc without l":    {Name: "invalid", K: 2, M: 1, Plugin: proto.String("lrc")},
+		"duplicate option": {Name: "invalid", K: 2, M: 1, Options: map[string]string{"k": "3"}},
+	} {
+		_, err := client.CreateProfile(tstCtx, req)
+		r.Error(err, name)
+		r.Contains(err.Error(), "InvalidArgument", name)
+	}
+}
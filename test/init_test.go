@@ -13,6 +13,7 @@ import (
 
 	cephapi "github.com/clyso/ceph-api"
 	"github.com/clyso/ceph-api/pkg/app"
+	"github.com/clyso/ceph-api/pkg/auth"
 	"github.com/clyso/ceph-api/pkg/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -25,6 +26,8 @@ var (
 	tstCtx   context.Context
 	grpcConn *grpc.ClientConn
 	admConn  *grpc.ClientConn
+	idp      *stubIdP
+	ldapSrv  *ldapStubServer
 )
 
 const (
@@ -47,6 +50,31 @@ func TestMain(m *testing.M) {
 	conf.App.AdminUsername = admin
 	conf.App.AdminPassword = pass
 	conf.App.BcryptPwdCost = 4
+	conf.Audit.Enabled = true
+
+	idp = newStubIdP()
+	conf.Auth.OIDC = auth.OIDCConfig{
+		Enabled:   true,
+		IssuerURL: idp.srv.URL,
+		ClientID:  stubIdPClientID,
+		RoleMapping: []auth.GroupRoles{
+			{Group: ssoAdminGroup, Roles: []string{"administrator"}},
+			{Group: ssoViewerGroup, Roles: []string{"read-only"}},
+		},
+	}
+
+	ldapSrv = newLDAPStubServer(ldapTestUsers()...)
+	conf.Auth.LDAP = auth.LDAPConfig{
+		Enabled:      true,
+		URL:          ldapSrv.URL(),
+		BindDN:       ldapServiceDN,
+		BindPassword: ldapServicePass,
+		UserBaseDN:   ldapBaseDN,
+		RoleMapping: []auth.GroupRoles{
+			{Group: ldapAdminGroupDN, Roles: []string{"administrator"}},
+			{Group: ldapViewerGroup, Roles: []string{"read-only"}},
+		},
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
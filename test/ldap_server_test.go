@@ -0,0 +1,175 @@
+package test
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	ldapBaseDN       = "ou=users,dc=example,dc=org"
+	ldapServiceDN    = "cn=ceph-api,dc=example,dc=org"
+	ldapServicePass  = "ceph-api-ldap-pass"
+	ldapAdminGroupDN = "cn=ceph-admins,ou=groups,dc=example,dc=org"
+	ldapViewerGroup  = "ceph-viewers"
+)
+
+type ldapEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// ldapStubServer is a minimal in-process LDAP server supporting simple bind and equality filter search.
+type ldapStubServer struct {
+	listener net.Listener
+	entries  []ldapEntry
+}
+
+func newLDAPStubServer(entries ...ldapEntry) *ldapStubServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	srv := &ldapStubServer{listener: l, entries: entries}
+	go srv.serve()
+	return srv
+}
+
+func (s *ldapStubServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *ldapStubServer) Close() {
+	_ = s.listener.Close()
+}
+
+func (s *ldapStubServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *ldapStubServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		msgID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		var responses []*ber.Packet
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			responses = append(responses, ldapResult(ldap.ApplicationBindResponse, s.bind(op)))
+		case ldap.ApplicationSearchRequest:
+			responses = append(s.search(op), ldapResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+		case ldap.ApplicationUnbindRequest:
+			return
+		default:
+			return
+		}
+		for _, resp := range responses {
+			msg := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, "MessageID"))
+			msg.AppendChild(resp)
+			if _, err = conn.Write(msg.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *ldapStubServer) bind(op *ber.Packet) uint16 {
+	dn := op.Children[1].Data.String()
+	password := op.Children[2].Data.String()
+	if dn == ldapServiceDN && password == ldapServicePass {
+		return ldap.LDAPResultSuccess
+	}
+	for _, e := range s.entries {
+		if e.dn == dn && e.password == password && password != "" {
+			return ldap.LDAPResultSuccess
+		}
+	}
+	return ldap.LDAPResultInvalidCredentials
+}
+
+func (s *ldapStubServer) search(op *ber.Packet) []*ber.Packet {
+	baseDN := op.Children[0].Data.String()
+	filter, err := ldap.DecompileFilter(op.Children[6])
+	if err != nil {
+		return nil
+	}
+	attr, val, ok := strings.Cut(strings.Trim(filter, "()"), "=")
+	if !ok {
+		return nil
+	}
+	var res []*ber.Packet
+	for _, e := range s.entries {
+		if !strings.HasSuffix(e.dn, baseDN) {
+			continue
+		}
+		found := false
+		for _, v := range e.attrs[attr] {
+			found = found || strings.EqualFold(v, val)
+		}
+		if !found {
+			continue
+		}
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "DN"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, vals := range e.attrs {
+			a := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			a.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, v := range vals {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+			}
+			a.AppendChild(set)
+			attrs.AppendChild(a)
+		}
+		entry.AppendChild(attrs)
+		res = append(res, entry)
+	}
+	return res
+}
+
+func ldapResult(tag ber.Tag, code uint16) *ber.Packet {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, fmt.Sprintf("result %d", code), "Diagnostic Message"))
+	return res
+}
+
+func ldapUser(uid, password string, groups ...string) ldapEntry {
+	return ldapEntry{
+		dn:       "uid=" + uid + "," + ldapBaseDN,
+		password: password,
+		attrs: map[string][]string{
+			"uid":      {uid},
+			"cn":       {uid + " ldap"},
+			"mail":     {uid + "@example.org"},
+			"memberOf": groups,
+		},
+	}
+}
+
+func ldapTestUsers() []ldapEntry {
+	return []ldapEntry{
+		ldapUser("ldap-admin", "ldap-admin-pass", ldapAdminGroupDN),
+		ldapUser("ldap-viewer", "ldap-viewer-pass", "cn="+ldapViewerGroup+",ou=groups,dc=example,dc=org"),
+		ldapUser("ldap-nogroup", "ldap-nogroup-pass", "cn=unmapped,ou=groups,dc=example,dc=org"),
+		// collides with local admin user
+		ldapUser(admin, "ldap-collision-pass", ldapAdminGroupDN),
+	}
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_Auth_LDAP_Login(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewAuthClient(grpcConn)
+	usersClient := pb.NewUsersClient(admConn)
+
+	username := "ldap-viewer"
+	res, err := client.Login(tstCtx, &pb.LoginReq{Username: username, Password: "ldap-viewer-pass"})
+	r.NoError(err)
+	r.EqualValues(username, res.Username)
+	r.False(res.Sso)
+	r.NotEmpty(res.Permissions)
+
+	// user provisioned with roles mapped from ldap groups
+	usr, err := usersClient.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.EqualValues([]string{"read-only"}, usr.Roles)
+	r.EqualValues(username+"@example.org", usr.GetEmail())
+	r.EqualValues(username+" ldap", usr.GetName())
+	r.True(usr.Enabled)
+
+	// login again with existing provisioned user
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: username, Password: "ldap-viewer-pass"})
+	r.NoError(err)
+
+	// disabled user cannot log in
+	_, err = usersClient.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Roles: usr.Roles, Enabled: false})
+	r.NoError(err)
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: username, Password: "ldap-viewer-pass"})
+	r.Error(err)
+}
+
+func Test_Auth_LDAP_Oauth_API(t *testing.T) {
+	r := require.New(t)
+	clusterClient := pb.NewClusterClient(grpcConn)
+
+	ctx, _, err := authenticateGrpcOauth("ldap-admin", "ldap-admin-pass")
+	r.NoError(err)
+	_, err = clusterClient.GetStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+
+	usr, err := pb.NewUsersClient(admConn).GetUser(tstCtx, &pb.GetUserReq{Username: "ldap-admin"})
+	r.NoError(err)
+	r.EqualValues([]string{"administrator"}, usr.Roles)
+}
+
+func Test_Auth_LDAP_InvalidCredentials(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewAuthClient(grpcConn)
+	usersClient := pb.NewUsersClient(admConn)
+
+	// wrong password
+	_, err := client.Login(tstCtx, &pb.LoginReq{Username: "ldap-admin", Password: "wrong-pass"})
+	r.Error(err)
+	// unknown user
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: "ldap-unknown", Password: "ldap-unknown-pass"})
+	r.Error(err)
+	// no mapped groups
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: "ldap-nogroup", Password: "ldap-nogroup-pass"})
+	r.Error(err)
+	_, err = usersClient.GetUser(tstCtx, &pb.GetUserReq{Username: "ldap-nogroup"})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+	// ldap user cannot take over local user
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: admin, Password: "ldap-collision-pass"})
+	r.Error(err)
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: admin, Password: pass})
+	r.NoError(err)
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_GetLastLog(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewLogsClient(admConn)
+
+	res, err := client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(5)})
+	r.NoError(err)
+	r.NotEmpty(res.Entries)
+	r.LessOrEqual(len(res.Entries), 5)
+	for _, e := range res.Entries {
+		r.NotEmpty(e.Name)
+		r.NotEmpty(e.Message)
+		r.EqualValues("cluster", e.Channel)
+	}
+
+	// default level is info, so debug entries are skipped
+	res, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(100)})
+	r.NoError(err)
+	r.NotEmpty(res.Entries)
+	for _, e := range res.Entries {
+		r.EqualValues("cluster", e.Channel)
+		r.NotEqualValues("[DBG]", e.Priority)
+	}
+	res, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(100), Level: proto.String("debug")})
+	r.NoError(err)
+	priorities := map[string]bool{}
+	for _, e := range res.Entries {
+		r.EqualValues("cluster", e.Channel)
+		priorities[e.Priority] = true
+	}
+	r.True(priorities["[DBG]"])
+	res, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(100), Level: proto.String("warn")})
+	r.NoError(err)
+	r.NotEmpty(res.Entries)
+	for _, e := range res.Entries {
+		r.Contains([]string{"[WRN]", "[ERR]"}, e.Priority)
+	}
+
+	res, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Channel: proto.String("audit"), Level: proto.String("debug")})
+	r.NoError(err)
+	r.NotEmpty(res.Entries)
+	for _, e := range res.Entries {
+		r.EqualValues("audit", e.Channel)
+	}
+
+	res, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(100), Channel: proto.String("*")})
+	r.NoError(err)
+	channels := map[string]bool{}
+	for _, e := range res.Entries {
+		channels[e.Channel] = true
+	}
+	r.True(channels["cluster"])
+	r.True(channels["audit"])
+}
+
+func Test_GetLastLogInvalidArgs(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewLogsClient(admConn)
+
+	_, err := client.GetLast(tstCtx, &pb.GetLastLogRequest{Channel: proto.String("unknown")})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Level: proto.String("unknown")})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.GetLast(tstCtx, &pb.GetLastLogRequest{Num: proto.Int32(0)})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
+
+func Test_TailLog(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewLogsClient(admConn)
+
+	ctx, cancel := context.WithTimeout(tstCtx, 30*time.Second)
+	defer cancel()
+	// audit channel has entries for every mon command, including the ones sent by tail itself
+	stream, err := client.Tail(ctx, &pb.TailLogRequest{Channel: proto.String("audit"), Level: proto.String("debug"), Num: 1})
+	r.NoError(err)
+	first, err := stream.Recv()
+	r.NoError(err)
+	r.EqualValues("audit", first.Channel)
+
+	next, err := stream.Recv()
+	r.NoError(err)
+	r.EqualValues("audit", next.Channel)
+	r.False(first.Name == next.Name && first.Seq == next.Seq, "tail must not send duplicate entries")
+}
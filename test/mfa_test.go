@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_Auth_MFA(t *testing.T) {
+	var (
+		username = "mfa-test-user"
+		pwd      = "mfa-test-pass"
+	)
+	r := require.New(t)
+	authClient := pb.NewAuthClient(grpcConn)
+	usersClient := pb.NewUsersClient(grpcConn)
+	admClient := pb.NewUsersClient(admConn)
+
+	_, err := admClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		admClient.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+
+	login, err := authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+	userCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+login.Token))
+
+	// user cannot manage MFA of other users
+	_, err = usersClient.EnrollTOTP(userCtx, &pb.GetUserReq{Username: admin})
+	r.Error(err)
+
+	enroll, err := usersClient.EnrollTOTP(userCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.NotEmpty(enroll.Secret)
+	r.Contains(enroll.Uri, "otpauth://totp/")
+	// MFA is not required until activated
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+
+	_, err = usersClient.ActivateTOTP(userCtx, &pb.TOTPCodeReq{Username: username, Code: "000000"})
+	r.Error(err)
+	code, err := totp.GenerateCode(enroll.Secret, time.Now())
+	r.NoError(err)
+	activated, err := usersClient.ActivateTOTP(userCtx, &pb.TOTPCodeReq{Username: username, Code: code})
+	r.NoError(err)
+	r.NotEmpty(activated.RecoveryCodes)
+
+	usr, err := admClient.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.True(usr.MfaEnabled)
+
+	// second factor required
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.Error(err)
+	r.Contains(err.Error(), "second factor required")
+	_, _, err = authenticateGrpcOauth(username, pwd)
+	r.Error(err)
+	// used code cannot be reused
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd, Otp: code})
+	r.Error(err)
+	// wrong password with valid code
+	nextCode, err := totp.GenerateCode(enroll.Secret, time.Now().Add(30*time.Second))
+	r.NoError(err)
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: "wrong", Otp: nextCode})
+	r.Error(err)
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd, Otp: nextCode})
+	r.NoError(err)
+
+	// recovery code with oauth password grant
+	status, token := oauthPasswordGrant(t, username, pwd, activated.RecoveryCodes[0])
+	r.EqualValues(http.StatusOK, status)
+	r.NotEmpty(token)
+	// recovery code is one-time
+	status, _ = oauthPasswordGrant(t, username, pwd, activated.RecoveryCodes[0])
+	r.EqualValues(http.StatusBadRequest, status)
+
+	// MFA state is kept on user update
+	_, err = admClient.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.Error(err)
+
+	// regenerate recovery codes
+	regenerated, err := usersClient.RegenerateRecoveryCodes(userCtx, &pb.TOTPCodeReq{Username: username, Code: activated.RecoveryCodes[1]})
+	r.NoError(err)
+	r.Len(regenerated.RecoveryCodes, len(activated.RecoveryCodes))
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd, Otp: activated.RecoveryCodes[2]})
+	r.Error(err)
+
+	// user cannot disable MFA without second factor
+	_, err = usersClient.DisableTOTP(userCtx, &pb.TOTPCodeReq{Username: username})
+	r.Error(err)
+	// admin resets MFA
+	_, err = admClient.DisableTOTP(tstCtx, &pb.TOTPCodeReq{Username: username})
+	r.NoError(err)
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+	usr, err = admClient.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.False(usr.MfaEnabled)
+
+	_, err = pb.NewStatusClient(grpcConn).GetCephStatus(userCtx, &emptypb.Empty{})
+	r.NoError(err)
+}
+
+func oauthPasswordGrant(t *testing.T, login, pass, otp string) (int, string) {
+	t.Helper()
+	resp, err := http.PostForm(httpAddr+"/api/oauth/token", url.Values{
+		"grant_type": {"password"},
+		"client_id":  {conf.Auth.ClientID},
+		"username":   {login},
+		"password":   {pass},
+		"otp":        {otp},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	res := struct {
+		Token string `json:"access_token"`
+	}{}
+	_ = json.NewDecoder(resp.Body).Decode(&res)
+	return resp.StatusCode, res.Token
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	stubIdPClientID = "ceph-api-e2e"
+	stubIdPKeyID    = "stub-key"
+	ssoAdminGroup   = "ceph-admins"
+	ssoViewerGroup  = "ceph-viewers"
+)
+
+// stubIdP is a minimal OIDC provider serving discovery and JWKS endpoints.
+type stubIdP struct {
+	srv *httptest.Server
+	key *rsa.PrivateKey
+}
+
+func newStubIdP() *stubIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	idp := &stubIdP{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   idp.srv.URL,
+			"jwks_uri": idp.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &key.PublicKey,
+			KeyID:     stubIdPKeyID,
+			Algorithm: "RS256",
+			Use:       "sig",
+		}}})
+	})
+	idp.srv = httptest.NewServer(mux)
+	return idp
+}
+
+func (i *stubIdP) idToken(username, audience string, groups ...string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                i.srv.URL,
+		"sub":                "id-" + username,
+		"aud":                audience,
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"iat":                time.Now().Unix(),
+		"preferred_username": username,
+		"groups":             groups,
+	})
+	token.Header["kid"] = stubIdPKeyID
+	res, err := token.SignedString(i.key)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func Test_Auth_OIDC_Login(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewAuthClient(grpcConn)
+
+	username := "sso-viewer"
+	res, err := client.Login(tstCtx, &pb.LoginReq{
+		Username: username,
+		Password: idp.idToken(username, stubIdPClientID, ssoViewerGroup, "unmapped-group"),
+	})
+	r.NoError(err)
+	r.True(res.Sso)
+	r.EqualValues(username, res.Username)
+	r.NotEmpty(res.Permissions)
+
+	authCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+res.Token))
+	_, err = pb.NewStatusClient(grpcConn).GetCephStatus(authCtx, &emptypb.Empty{})
+	r.NoError(err)
+	// read-only role cannot create users
+	_, err = pb.NewUsersClient(grpcConn).CreateUser(authCtx, &pb.CreateUserReq{Username: "sso-test-user", Password: "sso-test-pass", Roles: []string{"read-only"}})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	// federated user is not stored in accessdb
+	_, err = pb.NewUsersClient(admConn).GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+}
+
+func Test_Auth_OIDC_Oauth_API(t *testing.T) {
+	r := require.New(t)
+	clusterClient := pb.NewClusterClient(grpcConn)
+
+	username := "sso-admin"
+	ctx, token, err := authenticateGrpcOauth(username, idp.idToken(username, stubIdPClientID, ssoAdminGroup))
+	r.NoError(err)
+	r.NotEmpty(token.RefreshToken)
+	_, err = clusterClient.GetStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+
+	res, err := pb.NewAuthClient(grpcConn).Check(tstCtx, &pb.TokenCheckReq{Token: token.AccessToken})
+	r.NoError(err)
+	r.True(res.Sso)
+	r.EqualValues(username, res.Username)
+	r.NotEmpty(res.Permissions)
+}
+
+func Test_Auth_OIDC_InvalidToken(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewAuthClient(grpcConn)
+
+	username := "sso-invalid"
+	// wrong audience
+	_, err := client.Login(tstCtx, &pb.LoginReq{Username: username, Password: idp.idToken(username, "other-client", ssoAdminGroup)})
+	r.Error(err)
+	// no mapped groups
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: username, Password: idp.idToken(username, stubIdPClientID, "unmapped-group")})
+	r.Error(err)
+	// username does not match token
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: admin, Password: idp.idToken(username, stubIdPClientID, ssoAdminGroup)})
+	r.Error(err)
+	// token signed by unknown key
+	other := newStubIdP()
+	defer other.srv.Close()
+	other.srv.URL = idp.srv.URL
+	_, err = client.Login(tstCtx, &pb.LoginReq{Username: username, Password: other.idToken(username, stubIdPClientID, ssoAdminGroup)})
+	r.Error(err)
+}
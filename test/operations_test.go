@@ -0,0 +1,117 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func Test_Operations(t *testing.T) {
+	r := require.New(t)
+	const (
+		roleName   = "e2e-op-tenant-pools"
+		username   = "e2e-op-tenant-user"
+		pwd        = "Tenant-e2e-Pass-73"
+		tenantPool = "e2e-op-tenant-pool"
+		otherPool  = "e2e-op-other-pool"
+	)
+	usersClient := pb.NewUsersClient(admConn)
+	_, err := usersClient.CreateRole(tstCtx, &pb.Role{
+		Name: roleName,
+		ResourcePermissions: []*pb.ResourcePermission{{
+			Scope:       "pool",
+			Resources:   []string{"e2e-op-tenant-*"},
+			Permissions: []string{"read", "create", "update", "delete"},
+		}},
+	})
+	r.NoError(err)
+	_, err = usersClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{roleName}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		usersClient.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+		usersClient.DeleteRole(tstCtx, &pb.GetRoleReq{Name: roleName})
+	})
+	tenantCtx, token, err := authenticateGrpcOauth(username, pwd)
+	r.NoError(err)
+
+	poolClient := pb.NewPoolClient(admConn)
+	for _, name := range []string{tenantPool, otherPool} {
+		_, err = poolClient.CreatePool(tstCtx, &pb.CreatePoolRequest{Name: name, PgNum: proto.Int32(8)})
+		r.NoError(err)
+	}
+	t.Cleanup(func() {
+		poolClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: tenantPool, Confirm: true})
+		poolClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: otherPool, Confirm: true})
+	})
+
+	client := pb.NewOperationsClient(grpcConn)
+	tenantOp, err := pb.NewPoolClient(grpcConn).DeletePool(tenantCtx, &pb.DeletePoolRequest{Name: tenantPool, Confirm: true})
+	r.NoError(err)
+	r.NotEmpty(tenantOp.Name)
+	r.EqualValues("pool.delete", tenantOp.Metadata.Type)
+	r.EqualValues(tenantPool, tenantOp.Metadata.Target)
+	r.EqualValues(username, tenantOp.Metadata.Username)
+	otherOp, err := poolClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: otherPool, Confirm: true})
+	r.NoError(err)
+
+	tenantOp, err = client.WaitOperation(tenantCtx, &pb.WaitOperationRequest{Name: tenantOp.Name, Timeout: durationpb.New(time.Minute)})
+	r.NoError(err)
+	r.True(tenantOp.Done)
+	r.Nil(tenantOp.GetError())
+	r.NotNil(tenantOp.GetResponse())
+	r.NotNil(tenantOp.Metadata.EndTime)
+
+	// operations on other pools are not visible to tenant
+	_, err = client.GetOperation(tenantCtx, &pb.GetOperationRequest{Name: otherOp.Name})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+	list, err := client.ListOperations(tenantCtx, &pb.ListOperationsRequest{Type: proto.String("pool.delete")})
+	r.NoError(err)
+	for _, op := range list.Operations {
+		r.NotEqual(otherOp.Name, op.Name)
+	}
+	list, err = pb.NewOperationsClient(admConn).ListOperations(tstCtx, &pb.ListOperationsRequest{Type: proto.String("pool.delete")})
+	r.NoError(err)
+	var names []string
+	for _, op := range list.Operations {
+		names = append(names, op.Name)
+	}
+	r.Contains(names, tenantOp.Name)
+	r.Contains(names, otherOp.Name)
+
+	// REST clients poll operation by name
+	req, err := http.NewRequestWithContext(tstCtx, http.MethodGet, httpAddr+"/api/operations/"+tenantOp.Name, nil)
+	r.NoError(err)
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	resp, err := http.DefaultClient.Do(req)
+	r.NoError(err)
+	defer resp.Body.Close()
+	r.Equal(http.StatusOK, resp.StatusCode)
+	var restOp struct {
+		Name string `json:"name"`
+		Done bool   `json:"done"`
+	}
+	r.NoError(json.NewDecoder(resp.Body).Decode(&restOp))
+	r.Equal(tenantOp.Name, restOp.Name)
+	r.True(restOp.Done)
+
+	// finished operation cannot be cancelled, but can be deleted
+	_, err = client.CancelOperation(tenantCtx, &pb.GetOperationRequest{Name: tenantOp.Name})
+	r.Error(err)
+	r.Contains(err.Error(), "FailedPrecondition")
+	_, err = client.DeleteOperation(tenantCtx, &pb.GetOperationRequest{Name: tenantOp.Name})
+	r.NoError(err)
+	_, err = client.GetOperation(tenantCtx, &pb.GetOperationRequest{Name: tenantOp.Name})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+
+	_, err = client.GetOperation(tenantCtx, &pb.GetOperationRequest{Name: "not-an-operation"})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_OsdMarkIn_Reweight(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewOsdClient(admConn)
+	statusClient := pb.NewStatusClient(admConn)
+
+	dump, err := statusClient.GetCephOsdDump(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.NotEmpty(dump.Osds)
+	osdID := dump.Osds[0].Osd
+
+	// osd is already in, so both calls do not change cluster state
+	_, err = client.MarkIn(tstCtx, &pb.OsdIdsRequest{Ids: []int32{osdID}})
+	r.NoError(err)
+	_, err = client.Reweight(tstCtx, &pb.ReweightRequest{Id: osdID, Weight: 1})
+	r.NoError(err)
+}
+
+func Test_OsdDestroyNotSafe(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewOsdClient(admConn)
+	statusClient := pb.NewStatusClient(admConn)
+
+	dump, err := statusClient.GetCephOsdDump(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.NotEmpty(dump.Osds)
+
+	// osd is up and stores data, so it is not safe to destroy
+	_, err = client.Destroy(tstCtx, &pb.OsdIdRequest{Id: dump.Osds[0].Osd})
+	r.Error(err)
+	r.Contains(err.Error(), "FailedPrecondition")
+}
+
+func Test_OsdInvalidArgs(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewOsdClient(admConn)
+
+	_, err := client.MarkOut(tstCtx, &pb.OsdIdsRequest{})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.Reweight(tstCtx, &pb.ReweightRequest{Id: 0, Weight: 1.5})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.SetDeviceClass(tstCtx, &pb.SetDeviceClassRequest{Ids: []int32{0}})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.Purge(tstCtx, &pb.OsdIdRequest{Id: -1})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
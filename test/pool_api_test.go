@@ -0,0 +1,174 @@
+package test
+
+import (
+	"testing"
+
+	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func Test_ListPools(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewPoolClient(admConn)
+	res, err := client.ListPools(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.NotEmpty(res.Pools)
+}
+
+func Test_Pool_Create_Update_Delete(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewPoolClient(admConn)
+	const poolName = "test_pool"
+
+	_, err := client.CreatePool(tstCtx, &pb.CreatePoolRequest{
+		Name:        poolName,
+		PgNum:       proto.Int32(8),
+		Size:        proto.Int32(2),
+		Application: proto.String("rbd"),
+	})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: poolName, Confirm: true})
+	})
+
+	pool, err := client.GetPool(tstCtx, &pb.GetPoolRequest{Name: poolName})
+	r.NoError(err)
+	r.EqualValues(poolName, pool.PoolName)
+	r.EqualValues(2, pool.Size)
+	r.Contains(pool.ApplicationMetadata.AsMap(), "rbd")
+
+	listRes, err := client.ListPools(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	var poolNames []string
+	for _, p := range listRes.Pools {
+		poolNames = append(poolNames, p.PoolName)
+	}
+	r.Contains(poolNames, poolName)
+
+	_, err = client.UpdatePool(tstCtx, &pb.UpdatePoolRequest{
+		Name:    poolName,
+		Size:    proto.Int32(3),
+		MinSize: proto.Int32(1),
+	})
+	r.NoError(err)
+
+	pool, err = client.GetPool(tstCtx, &pb.GetPoolRequest{Name: poolName})
+	r.NoError(err)
+	r.EqualValues(3, pool.Size)
+	r.EqualValues(1, pool.MinSize)
+
+	// deletion must be confirmed
+	_, err = client.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: poolName})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+
+	op, err := client.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: poolName, Confirm: true})
+	r.NoError(err)
+	op, err = pb.NewOperationsClient(admConn).WaitOperation(tstCtx, &pb.WaitOperationRequest{Name: op.Name})
+	r.NoError(err)
+	r.True(op.Done)
+	r.Nil(op.GetError())
+
+	_, err = client.GetPool(tstCtx, &pb.GetPoolRequest{Name: poolName})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+}
+
+func Test_GetNonExistingPool(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewPoolClient(admConn)
+
+	_, err := client.GetPool(tstCtx, &pb.GetPoolRequest{Name: "non_existing_pool"})
+	r.Error(err)
+	r.Contains(err.Error(), "NotFound")
+}
+
+func Test_CreatePoolWithoutName(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewPoolClient(admConn)
+
+	_, err := client.CreatePool(tstCtx, &pb.CreatePoolRequest{PgNum: proto.Int32(8)})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}
+
+func Test_Pool_ResourcePermissions(t *testing.T) {
+	r := require.New(t)
+	const (
+		roleName   = "e2e-tenant-pools"
+		username   = "e2e-tenant-user"
+		pwd        = "Tenant-e2e-Pass-73"
+		tenantPool = "e2e-tenant-pool"
+		otherPool  = "e2e-other-pool"
+	)
+	usersClient := pb.NewUsersClient(admConn)
+	_, err := usersClient.CreateRole(tstCtx, &pb.Role{
+		Name: roleName,
+		ResourcePermissions: []*pb.ResourcePermission{{
+			Scope:       "pool",
+			Resources:   []string{"e2e-tenant-*"},
+			Permissions: []string{"read", "create", "update", "delete"},
+		}},
+	})
+	r.NoError(err)
+	t.Cleanup(func() {
+		usersClient.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+		usersClient.DeleteRole(tstCtx, &pb.GetRoleReq{Name: roleName})
+	})
+	role, err := usersClient.GetRole(tstCtx, &pb.GetRoleReq{Name: roleName})
+	r.NoError(err)
+	r.Len(role.ResourcePermissions, 1)
+	r.EqualValues([]string{"e2e-tenant-*"}, role.ResourcePermissions[0].Resources)
+
+	_, err = usersClient.CreateRole(tstCtx, &pb.Role{
+		Name:                roleName + "-invalid",
+		ResourcePermissions: []*pb.ResourcePermission{{Scope: "pool", Resources: []string{"["}, Permissions: []string{"read"}}},
+	})
+	r.Error(err, "invalid resource glob")
+
+	_, err = usersClient.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{roleName}, Enabled: true})
+	r.NoError(err)
+	tenantCtx, _, err := authenticateGrpcOauth(username, pwd)
+	r.NoError(err)
+
+	admClient := pb.NewPoolClient(admConn)
+	_, err = admClient.CreatePool(tstCtx, &pb.CreatePoolRequest{Name: otherPool, PgNum: proto.Int32(8)})
+	r.NoError(err)
+	t.Cleanup(func() {
+		admClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: otherPool, Confirm: true})
+		admClient.DeletePool(tstCtx, &pb.DeletePoolRequest{Name: tenantPool, Confirm: true})
+	})
+
+	client := pb.NewPoolClient(grpcConn)
+	_, err = client.CreatePool(tenantCtx, &pb.CreatePoolRequest{Name: tenantPool, PgNum: proto.Int32(8)})
+	r.NoError(err)
+	_, err = client.CreatePool(tenantCtx, &pb.CreatePoolRequest{Name: "e2e-not-tenant-pool", PgNum: proto.Int32(8)})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	// only own pools are listed
+	listRes, err := client.ListPools(tenantCtx, &emptypb.Empty{})
+	r.NoError(err)
+	var poolNames []string
+	for _, p := range listRes.Pools {
+		poolNames = append(poolNames, p.PoolName)
+	}
+	r.EqualValues([]string{tenantPool}, poolNames)
+
+	_, err = client.GetPool(tenantCtx, &pb.GetPoolRequest{Name: tenantPool})
+	r.NoError(err)
+	_, err = client.GetPool(tenantCtx, &pb.GetPoolRequest{Name: otherPool})
+	r.Error(err)
+	_, err = client.UpdatePool(tenantCtx, &pb.UpdatePoolRequest{Name: otherPool, Size: proto.Int32(2)})
+	r.Error(err)
+	_, err = client.DeletePool(tenantCtx, &pb.DeletePoolRequest{Name: otherPool, Confirm: true})
+	r.Error(err)
+
+	_, err = client.UpdatePool(tenantCtx, &pb.UpdatePoolRequest{Name: tenantPool, Size: proto.Int32(2)})
+	r.NoError(err)
+	_, err = client.DeletePool(tenantCtx, &pb.DeletePoolRequest{Name: tenantPool, Confirm: true})
+	r.NoError(err)
+}
@@ -1,6 +1,7 @@
 package test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -24,6 +25,30 @@ func Test_List_Ceph_Status(t *testing.T) {
 
 }
 
+func Test_WatchCephStatus(t *testing.T) {
+	r := require.New(t)
+	client := pb.NewStatusClient(admConn)
+
+	ctx, cancel := context.WithTimeout(tstCtx, 30*time.Second)
+	defer cancel()
+	stream, err := client.WatchCephStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+	// current status is sent right after subscription
+	res, err := stream.Recv()
+	r.NoError(err)
+	r.NotEmpty(res.Fsid)
+	r.NotEmpty(res.Health)
+	r.NotEmpty(res.Osdmap)
+	r.NotEmpty(res.Monmap)
+
+	// second subscriber shares the same poller and also gets current status
+	stream2, err := client.WatchCephStatus(ctx, &emptypb.Empty{})
+	r.NoError(err)
+	res2, err := stream2.Recv()
+	r.NoError(err)
+	r.EqualValues(res.Fsid, res2.Fsid)
+}
+
 func Test_GetCephMonDump(t *testing.T) {
 	r := require.New(t)
 	client := pb.NewStatusClient(admConn)
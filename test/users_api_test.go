@@ -1,15 +1,19 @@
 package test
 
 import (
+	"bytes"
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
 	pb "github.com/clyso/ceph-api/api/gen/grpc/go"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_Users_CRUD(t *testing.T) {
@@ -137,6 +141,8 @@ func Test_Roles_CRUD(t *testing.T) {
 
 	role, err := client.GetRole(tstCtx, &pb.GetRoleReq{Name: name})
 	r.NoError(err)
+	r.NotEmpty(role.GetVersion())
+	roleReq.Version = role.Version
 	r.True(proto.Equal(roleReq, role))
 
 	roles, err := client.ListRoles(tstCtx, &emptypb.Empty{})
@@ -168,6 +174,8 @@ func Test_Roles_CRUD(t *testing.T) {
 
 	role, err = client.GetRole(tstCtx, &pb.GetRoleReq{Name: name})
 	r.NoError(err)
+	r.NotEqual(roleReq.GetVersion(), role.GetVersion(), "version is changed on update")
+	updRole.Version = role.Version
 	r.True(proto.Equal(role, updRole))
 
 	roles, err = client.ListRoles(tstCtx, &emptypb.Empty{})
@@ -188,3 +196,361 @@ func Test_Roles_CRUD(t *testing.T) {
 	_, err = client.GetRole(tstCtx, &pb.GetRoleReq{Name: name})
 	r.Error(err)
 }
+
+func Test_Roles_Version(t *testing.T) {
+	r := require.New(t)
+	const name = "test-role-version"
+	client := pb.NewUsersClient(admConn)
+	role := &pb.Role{
+		Name:              name,
+		ScopesPermissions: map[string]*structpb.ListValue{"pool": {Values: []*structpb.Value{structpb.NewStringValue("read")}}},
+	}
+	_, err := client.CreateRole(tstCtx, role)
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteRole(tstCtx, &pb.GetRoleReq{Name: name})
+	})
+	got, err := client.GetRole(tstCtx, &pb.GetRoleReq{Name: name})
+	r.NoError(err)
+	v1 := got.GetVersion()
+	r.NotEmpty(v1)
+
+	// update with current version succeeds
+	got.Description = proto.String("first")
+	_, err = client.UpdateRole(tstCtx, got)
+	r.NoError(err)
+	// update based on outdated version is rejected
+	got.Description = proto.String("second")
+	_, err = client.UpdateRole(tstCtx, got)
+	r.Error(err)
+	r.Contains(err.Error(), "FailedPrecondition")
+	// update without version overwrites role
+	got.Version = nil
+	_, err = client.UpdateRole(tstCtx, got)
+	r.NoError(err)
+
+	// REST clients use ETag and If-Match headers
+	_, token, err := authenticateGrpcOauth(admin, pass)
+	r.NoError(err)
+	doHTTP := func(method string, body []byte, ifMatch string) *http.Response {
+		req, err := http.NewRequestWithContext(tstCtx, method, httpAddr+"/api/role/"+name, bytes.NewReader(body))
+		r.NoError(err)
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		r.NoError(err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	resp := doHTTP(http.MethodGet, nil, "")
+	r.Equal(http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	r.NotEmpty(etag)
+	body := []byte(`{"scopes_permissions": {"pool": ["read", "update"]}}`)
+	resp = doHTTP(http.MethodPut, body, etag)
+	r.Equal(http.StatusOK, resp.StatusCode)
+	resp = doHTTP(http.MethodPut, body, etag)
+	r.Equal(http.StatusBadRequest, resp.StatusCode, "etag is outdated")
+	resp = doHTTP(http.MethodGet, nil, "")
+	r.NotEqual(etag, resp.Header.Get("ETag"))
+}
+
+func Test_Users_Version(t *testing.T) {
+	r := require.New(t)
+	const username = "test-user-version"
+	client := pb.NewUsersClient(admConn)
+	_, err := client.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: "Version-e2e-Pass-73", Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+	usr, err := client.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.NotEmpty(usr.Version)
+
+	upd := &pb.CreateUserReq{Username: username, Roles: []string{"read-only"}, Enabled: true, Version: proto.String(usr.Version)}
+	_, err = client.UpdateUser(tstCtx, upd)
+	r.NoError(err)
+	// version is changed even if user is updated within the same second
+	_, err = client.UpdateUser(tstCtx, upd)
+	r.Error(err)
+	r.Contains(err.Error(), "FailedPrecondition")
+
+	usr, err = client.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	upd.Version = proto.String(usr.Version)
+	_, err = client.UpdateUser(tstCtx, upd)
+	r.NoError(err)
+}
+
+func Test_ServiceAccounts(t *testing.T) {
+	var (
+		name  = "test-ci"
+		descr = "ci pipeline"
+	)
+	r := require.New(t)
+	client := pb.NewUsersClient(admConn)
+	statusClient := pb.NewStatusClient(grpcConn)
+
+	_, err := client.GetServiceAccount(tstCtx, &pb.GetServiceAccountReq{Name: name})
+	r.Error(err, "service account %s should not exist", name)
+
+	_, err = client.CreateServiceAccount(tstCtx, &pb.ServiceAccount{Name: name, Description: &descr, Roles: []string{"read-only", "pool-manager"}})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteServiceAccount(tstCtx, &pb.GetServiceAccountReq{Name: name})
+	})
+	_, err = client.CreateServiceAccount(tstCtx, &pb.ServiceAccount{Name: name, Roles: []string{"read-only"}})
+	r.Error(err, "duplicate service account")
+	_, err = client.CreateServiceAccount(tstCtx, &pb.ServiceAccount{Name: admin, Roles: []string{"read-only"}})
+	r.Error(err, "service account name conflicts with user")
+
+	list, err := client.ListServiceAccounts(tstCtx, &emptypb.Empty{})
+	r.NoError(err)
+	found := false
+	for _, a := range list.ServiceAccounts {
+		if a.Name == name {
+			found = true
+			r.EqualValues(descr, a.GetDescription())
+			r.ElementsMatch([]string{"read-only", "pool-manager"}, a.Roles)
+		}
+	}
+	r.True(found)
+
+	// key roles must be subset of service account roles
+	_, err = client.CreateAPIKey(tstCtx, &pb.CreateAPIKeyReq{ServiceAccount: name, Name: "admin-key", Roles: []string{"administrator"}})
+	r.Error(err)
+
+	key, err := client.CreateAPIKey(tstCtx, &pb.CreateAPIKeyReq{ServiceAccount: name, Name: "monitoring", Roles: []string{"read-only"}})
+	r.NoError(err)
+	r.NotEmpty(key.Token)
+	r.NotEmpty(key.Key.Id)
+	r.Nil(key.Key.LastUsed)
+
+	authCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+key.Token))
+	_, err = statusClient.GetCephStatus(authCtx, &emptypb.Empty{})
+	r.NoError(err)
+	// read-only key cannot create users
+	_, err = pb.NewUsersClient(grpcConn).CreateUser(authCtx, &pb.CreateUserReq{Username: "sa-test-user", Password: "sa-test-pass", Roles: []string{"read-only"}})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	keys, err := client.ListAPIKeys(tstCtx, &pb.GetServiceAccountReq{Name: name})
+	r.NoError(err)
+	r.Len(keys.Keys, 1)
+	r.EqualValues("monitoring", keys.Keys[0].Name)
+	r.NotNil(keys.Keys[0].LastUsed)
+
+	// expired key
+	expired, err := client.CreateAPIKey(tstCtx, &pb.CreateAPIKeyReq{ServiceAccount: name, Name: "expiring", ExpiresAt: timestamppb.New(time.Now().Add(2 * time.Second))})
+	r.NoError(err)
+	expCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+expired.Token))
+	_, err = statusClient.GetCephStatus(expCtx, &emptypb.Empty{})
+	r.NoError(err)
+	r.Eventually(func() bool {
+		_, err = statusClient.GetCephStatus(expCtx, &emptypb.Empty{})
+		return err != nil
+	}, 5*time.Second, 500*time.Millisecond)
+
+	// revoked key
+	_, err = client.RevokeAPIKey(tstCtx, &pb.RevokeAPIKeyReq{ServiceAccount: name, Id: key.Key.Id})
+	r.NoError(err)
+	_, err = statusClient.GetCephStatus(authCtx, &emptypb.Empty{})
+	r.Error(err)
+	r.Contains(err.Error(), "Unauthenticated")
+
+	// invalid key
+	invalidCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+expired.Token[:len(expired.Token)-1]))
+	_, err = statusClient.GetCephStatus(invalidCtx, &emptypb.Empty{})
+	r.Error(err)
+
+	_, err = client.DeleteServiceAccount(tstCtx, &pb.GetServiceAccountReq{Name: name})
+	r.NoError(err)
+	_, err = client.GetServiceAccount(tstCtx, &pb.GetServiceAccountReq{Name: name})
+	r.Error(err)
+}
+
+func Test_Users_PwdPolicy(t *testing.T) {
+	var (
+		username = "pwd-policy-user"
+		pwd      = "pwd-policy-pass"
+	)
+	r := require.New(t)
+	client := pb.NewUsersClient(admConn)
+
+	_, err := client.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: "short", Roles: []string{"read-only"}, Enabled: true})
+	r.Error(err, "password shorter than min length")
+	r.Contains(err.Error(), "InvalidArgument")
+
+	_, err = client.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+	_, err = client.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: "short", Roles: []string{"read-only"}, Enabled: true})
+	r.Error(err, "password shorter than min length")
+
+	res, err := pb.NewAuthClient(grpcConn).Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+	r.EqualValues(0, res.PwdExpirationWarning)
+	userCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("Authorization", "Bearer "+res.Token))
+	userClient := pb.NewUsersClient(grpcConn)
+
+	_, err = userClient.UserChangePassword(userCtx, &pb.UserChangePasswordReq{Username: username, OldPassword: pwd, NewPassword: pwd})
+	r.Error(err, "new password equals old password")
+	_, err = userClient.UserChangePassword(userCtx, &pb.UserChangePasswordReq{Username: username, OldPassword: pwd, NewPassword: "short"})
+	r.Error(err, "new password shorter than min length")
+	_, err = userClient.UserChangePassword(userCtx, &pb.UserChangePasswordReq{Username: username, OldPassword: pwd, NewPassword: pwd + "-new"})
+	r.NoError(err)
+	_, err = pb.NewAuthClient(grpcConn).Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd + "-new"})
+	r.NoError(err)
+
+	// expiration warning
+	expiration := timestamppb.New(time.Now().Add(time.Hour))
+	_, err = client.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true, PwdExpirationDate: expiration})
+	r.NoError(err)
+	res, err = pb.NewAuthClient(grpcConn).Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+	r.EqualValues(2, res.PwdExpirationWarning)
+
+	// expired password
+	expiration = timestamppb.New(time.Now().Add(-time.Minute))
+	_, err = client.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true, PwdExpirationDate: expiration})
+	r.NoError(err)
+	_, err = pb.NewAuthClient(grpcConn).Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.Error(err)
+}
+
+func Test_Users_AccountLockout(t *testing.T) {
+	var (
+		username = "lockout-user"
+		pwd      = "lockout-pass"
+	)
+	r := require.New(t)
+	client := pb.NewUsersClient(admConn)
+	authClient := pb.NewAuthClient(grpcConn)
+
+	_, err := client.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{"read-only"}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+	})
+
+	// successful login resets failed attempts
+	for i := 0; i < conf.App.AccountLockoutAttempts-1; i++ {
+		_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: "wrong-pass"})
+		r.Error(err)
+	}
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+
+	for i := 0; i < conf.App.AccountLockoutAttempts; i++ {
+		_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: "wrong-pass"})
+		r.Error(err)
+	}
+	// user is locked
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.Error(err)
+	usr, err := client.GetUser(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.False(usr.Enabled)
+
+	// admin unlocks user
+	_, err = client.UpdateUser(tstCtx, &pb.CreateUserReq{Username: username, Roles: usr.Roles, Enabled: true})
+	r.NoError(err)
+	_, err = authClient.Login(tstCtx, &pb.LoginReq{Username: username, Password: pwd})
+	r.NoError(err)
+}
+
+func Test_Users_EffectivePermissions(t *testing.T) {
+	r := require.New(t)
+	const (
+		roleName = "e2e-explain-role"
+		username = "e2e-explain-user"
+		pwd      = "Explain-e2e-Pass-91"
+		// user without user scope permissions
+		poolUsername = "e2e-explain-pool-user"
+	)
+	client := pb.NewUsersClient(admConn)
+	_, err := client.CreateRole(tstCtx, &pb.Role{
+		Name: roleName,
+		ScopesPermissions: map[string]*structpb.ListValue{
+			"monitor": {Values: []*structpb.Value{structpb.NewStringValue("read")}},
+		},
+		ResourcePermissions: []*pb.ResourcePermission{{Scope: "pool", Resources: []string{"e2e-explain-*"}, Permissions: []string{"read", "update"}}},
+	})
+	r.NoError(err)
+	_, err = client.CreateUser(tstCtx, &pb.CreateUserReq{Username: username, Password: pwd, Roles: []string{roleName, "read-only"}, Enabled: true})
+	r.NoError(err)
+	_, err = client.CreateUser(tstCtx, &pb.CreateUserReq{Username: poolUsername, Password: pwd, Roles: []string{roleName}, Enabled: true})
+	r.NoError(err)
+	t.Cleanup(func() {
+		client.DeleteUser(tstCtx, &pb.GetUserReq{Username: username})
+		client.DeleteUser(tstCtx, &pb.GetUserReq{Username: poolUsername})
+		client.DeleteRole(tstCtx, &pb.GetRoleReq{Name: roleName})
+	})
+
+	res, err := client.GetEffectivePermissions(tstCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	r.EqualValues([]string{roleName, "read-only"}, res.Roles)
+	grantRoles := func(grants []*pb.PermissionGrant, scope, perm, resource string) []string {
+		for _, g := range grants {
+			if g.Scope == scope && g.Permission == perm && g.GetResource() == resource {
+				return g.Roles
+			}
+		}
+		return nil
+	}
+	r.ElementsMatch([]string{roleName, "read-only"}, grantRoles(res.Permissions, "monitor", "read", ""))
+	r.EqualValues([]string{roleName}, grantRoles(res.Permissions, "pool", "update", "e2e-explain-*"))
+	r.Nil(grantRoles(res.Permissions, "pool", "update", ""))
+
+	_, err = client.GetEffectivePermissions(tstCtx, &pb.GetUserReq{Username: "e2e-explain-non-existing"})
+	r.Error(err)
+
+	userCtx, _, err := authenticateGrpcOauth(username, pwd)
+	r.NoError(err)
+	userClient := pb.NewUsersClient(grpcConn)
+	// permissions of others are visible with user read permission granted by read-only role
+	_, err = userClient.GetEffectivePermissions(userCtx, &pb.GetUserReq{Username: username})
+	r.NoError(err)
+	_, err = userClient.GetEffectivePermissions(userCtx, &pb.GetUserReq{Username: admin})
+	r.NoError(err)
+
+	// user without user scope can see own permissions but not permissions of others
+	poolUserCtx, _, err := authenticateGrpcOauth(poolUsername, pwd)
+	r.NoError(err)
+	_, err = userClient.GetEffectivePermissions(poolUserCtx, &pb.GetUserReq{Username: poolUsername})
+	r.NoError(err)
+	_, err = userClient.GetEffectivePermissions(poolUserCtx, &pb.GetUserReq{Username: admin})
+	r.Error(err)
+	r.Contains(err.Error(), "PermissionDenied")
+
+	canI, err := userClient.CanI(userCtx, &pb.CanIReq{Scope: "pool", Permission: "read"})
+	r.NoError(err)
+	r.True(canI.Allowed)
+	r.EqualValues(username, canI.Username)
+	r.Contains(canI.Reason, "read-only")
+
+	canI, err = userClient.CanI(userCtx, &pb.CanIReq{Scope: "pool", Permission: "update"})
+	r.NoError(err)
+	r.False(canI.Allowed)
+	r.Contains(canI.Reason, "e2e-explain-*")
+
+	canI, err = userClient.CanI(userCtx, &pb.CanIReq{Scope: "pool", Permission: "update", Resource: proto.String("e2e-explain-pool")})
+	r.NoError(err)
+	r.True(canI.Allowed)
+	r.Len(canI.GrantedBy, 1)
+	r.EqualValues([]string{roleName}, canI.GrantedBy[0].Roles)
+
+	canI, err = userClient.CanI(userCtx, &pb.CanIReq{Scope: "user", Permission: "delete"})
+	r.NoError(err)
+	r.False(canI.Allowed)
+	r.Empty(canI.GrantedBy)
+
+	_, err = userClient.CanI(userCtx, &pb.CanIReq{Scope: "pool", Permission: "explode"})
+	r.Error(err)
+	r.Contains(err.Error(), "InvalidArgument")
+}